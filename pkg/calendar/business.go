@@ -0,0 +1,178 @@
+package calendar
+
+// This file implements business-day arithmetic.  The calculations move by
+// whole weeks with date arithmetic and then adjust for the holidays reported
+// by the calendar, so the work is proportional to the number of holidays in
+// the span rather than the number of days.  The work days of a week are the
+// days that are not in the weekend of the calendar.
+//
+// Endpoint semantics:
+//
+//	-- AddBusinessDays(date, n, calendar) with n > 0 returns the nth business
+//	   day after date.  The date itself is never counted.  With n < 0, it
+//	   returns the |n|th business day before date.  With n = 0, it returns
+//	   date unchanged, even if date is not a business day.
+//	-- BusinessDaysBetween(from, to, calendar) counts the business days after
+//	   from up to and including to.  If to is before from, it counts the
+//	   business days from to up to but not including from, as a negative
+//	   number.  Therefore, if AddBusinessDays(from, n, calendar) = to, then
+//	   BusinessDaysBetween(from, to, calendar) = n.
+//	-- BusinessDaysIn(dateRange, calendar) counts the business days in the
+//	   date range including both the first and last dates.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	d "github.com/waysys/waydate/pkg/date"
+	dr "github.com/waysys/waydate/pkg/daterange"
+)
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// AddBusinessDays returns the date that is num business days after the date
+// if num > 0, or num business days before the date if num < 0.  An error is
+// returned if the result is outside the range of dates.
+func AddBusinessDays(date d.Date, num int, calendar HolidayCalendar) (d.Date, error) {
+	var err error
+	var current = date
+	var target d.Date
+	var remaining = num
+	var weekend = calendar.Weekend()
+	var direction = 1
+	if num < 0 {
+		direction = -1
+		remaining = -num
+	}
+
+	// Invariant:
+	//   The result is remaining business days from current in the direction.
+	// Bound Function: the number of holidays between current and the result.
+	for remaining > 0 {
+		target, err = addWorkDays(current, direction*remaining, weekend)
+		if err != nil {
+			return date, err
+		}
+		remaining = countHolidays(current, target, calendar)
+		current = target
+	}
+	// Postcondition:
+	//   err != nil or BusinessDaysBetween(date, current, calendar) = num
+	return current, nil
+}
+
+// BusinessDaysBetween returns the number of business days after from up to
+// and including to.  If to is before from, the result is the negative of the
+// number of business days from to up to but not including from.
+func BusinessDaysBetween(from d.Date, to d.Date, calendar HolidayCalendar) int {
+	var result int
+	var weekend = calendar.Weekend()
+	switch {
+	case to.After(from):
+		result = countWorkDays(from, to, weekend) - countHolidays(from, to, calendar)
+	case to.Before(from):
+		result = -(countWorkDays(to, from, weekend) - countHolidays(to, from, calendar))
+		if calendar.IsBusinessDay(to) {
+			result--
+		}
+		if calendar.IsBusinessDay(from) {
+			result++
+		}
+	default:
+		result = 0
+	}
+	return result
+}
+
+// BusinessDaysIn returns the number of business days in the date range,
+// including both the first and last dates.
+func BusinessDaysIn(dateRange dr.DateRange, calendar HolidayCalendar) int {
+	var result = BusinessDaysBetween(dateRange.First(), dateRange.Last(), calendar)
+	if calendar.IsBusinessDay(dateRange.First()) {
+		result++
+	}
+	return result
+}
+
+// addWorkDays returns the date that is num work days after the date if
+// num > 0, or before the date if num < 0, ignoring holidays.  Since every
+// seven consecutive days contain the same number of work days, whole weeks
+// are added directly and only the remaining work days are stepped through.
+func addWorkDays(date d.Date, num int, weekend d.Weekend) (d.Date, error) {
+	var err error
+	var direction = 1
+	if num < 0 {
+		direction = -1
+		num = -num
+	}
+	var workDaysPerWeek = weekend.WorkDaysPerWeek()
+	var weeks = num / workDaysPerWeek
+	var remainder = num % workDaysPerWeek
+	if remainder == 0 && weeks > 0 {
+		weeks--
+		remainder = workDaysPerWeek
+	}
+	var result d.Date
+	result, err = d.Add(date, direction*7*weeks)
+	if err != nil {
+		return date, err
+	}
+	for remainder > 0 {
+		result, err = d.Add(result, direction)
+		if err != nil {
+			return date, err
+		}
+		if weekend.IsWorkDay(result) {
+			remainder--
+		}
+	}
+	return result, nil
+}
+
+// countWorkDays returns the number of work days after from up to and
+// including to, ignoring holidays.
+//
+// Precondition: from is before to
+func countWorkDays(from d.Date, to d.Date, weekend d.Weekend) int {
+	var days = d.Difference(to, from)
+	var result = (days / 7) * weekend.WorkDaysPerWeek()
+	var current, _ = d.Add(from, (days/7)*7)
+	for current.Before(to) {
+		current, _ = current.Increment()
+		if weekend.IsWorkDay(current) {
+			result++
+		}
+	}
+	return result
+}
+
+// countHolidays returns the number of holidays that fall on work days
+// strictly between date1 and date2 or on date2.  The dates may be in either
+// order.
+func countHolidays(date1 d.Date, date2 d.Date, calendar HolidayCalendar) int {
+	var first d.Date
+	var last d.Date
+	var err error
+	if date1.Before(date2) {
+		first, err = date1.Increment()
+		last = date2
+	} else {
+		first = date2
+		last, err = date1.Decrement()
+	}
+	if err != nil || last.Before(first) {
+		return 0
+	}
+	var dateRange, _ = dr.New(first, last)
+	var weekend = calendar.Weekend()
+	var count = 0
+	for _, holiday := range calendar.HolidaysIn(dateRange) {
+		if weekend.IsWorkDay(holiday.Date) {
+			count++
+		}
+	}
+	return count
+}
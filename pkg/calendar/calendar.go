@@ -0,0 +1,169 @@
+// Package calendar implements holiday calendars and business-day queries.
+// Structures in this package are intended to be invariant.
+package calendar
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	d "github.com/waysys/waydate/pkg/date"
+	dr "github.com/waysys/waydate/pkg/daterange"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Holiday is a named non-working day.
+type Holiday struct {
+	Name string
+	Date d.Date
+}
+
+// HolidayCalendar is the interface implemented by holiday calendars.  A
+// business day is a day that is neither a weekend day nor a holiday.
+type HolidayCalendar interface {
+	// Name returns the name of the calendar.
+	Name() string
+	// IsHoliday returns true if the date is a holiday.
+	IsHoliday(date d.Date) bool
+	// IsBusinessDay returns true if the date is neither a weekend day nor a holiday.
+	IsBusinessDay(date d.Date) bool
+	// Weekend returns the days of the week that are not business days.
+	Weekend() d.Weekend
+	// HolidaysIn returns the holidays in the date range in date order.
+	HolidaysIn(dateRange dr.DateRange) []Holiday
+	// HolidayName returns the name of the holiday on the date, if any.
+	HolidayName(date d.Date) (string, bool)
+	// FindHoliday returns the holiday with the name in the year, if any.
+	// Names are matched without regard to case.
+	FindHoliday(name string, year d.Year) (Holiday, bool)
+}
+
+// SetCalendar is a holiday calendar backed by a set of dates.
+type SetCalendar struct {
+	name     string
+	weekend  d.Weekend
+	holidays []Holiday
+	names    map[d.Date]string
+}
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewSetCalendar creates a holiday calendar with the specified holidays and a
+// Saturday and Sunday weekend.  Each holiday must have a name and a valid
+// date, and no two holidays may fall on the same date.
+func NewSetCalendar(name string, holidays ...Holiday) (SetCalendar, error) {
+	return NewSetCalendarWithWeekend(name, d.SaturdaySunday, holidays...)
+}
+
+// NewSetCalendarWithWeekend creates a holiday calendar with the specified
+// weekend and holidays.  Each holiday must have a name and a valid date, and
+// no two holidays may fall on the same date.
+func NewSetCalendarWithWeekend(name string, weekend d.Weekend, holidays ...Holiday) (SetCalendar, error) {
+	var err error
+	var calendar = SetCalendar{
+		name:     name,
+		weekend:  weekend,
+		holidays: make([]Holiday, 0, len(holidays)),
+		names:    make(map[d.Date]string, len(holidays)),
+	}
+
+	for _, holiday := range holidays {
+		err = d.IsADate(holiday.Date)
+		if err != nil {
+			return SetCalendar{}, errors.New("calendar.NewSetCalendar: holiday " + holiday.Name + ": " + err.Error())
+		}
+		if strings.TrimSpace(holiday.Name) == "" {
+			return SetCalendar{}, errors.New("calendar.NewSetCalendar: holiday on " + holiday.Date.String() +
+				" must have a name")
+		}
+		var existing, found = calendar.names[holiday.Date]
+		if found {
+			return SetCalendar{}, errors.New("calendar.NewSetCalendar: holidays " + existing + " and " +
+				holiday.Name + " are both on " + holiday.Date.String())
+		}
+		calendar.names[holiday.Date] = holiday.Name
+		calendar.holidays = append(calendar.holidays, holiday)
+	}
+	sortHolidays(calendar.holidays)
+	return calendar, nil
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// sortHolidays sorts holidays in date order.
+func sortHolidays(holidays []Holiday) {
+	slices.SortFunc(holidays, func(holiday1 Holiday, holiday2 Holiday) int {
+		return int(holiday1.Date.Compare(holiday2.Date))
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Name returns the name of the calendar.
+func (calendar SetCalendar) Name() string {
+	return calendar.name
+}
+
+// IsHoliday returns true if the date is a holiday.
+func (calendar SetCalendar) IsHoliday(date d.Date) bool {
+	var _, found = calendar.names[date]
+	return found
+}
+
+// IsBusinessDay returns true if the date is neither a weekend day nor a holiday.
+func (calendar SetCalendar) IsBusinessDay(date d.Date) bool {
+	return calendar.weekend.IsWorkDay(date) && !calendar.IsHoliday(date)
+}
+
+// Weekend returns the days of the week that are not business days.
+func (calendar SetCalendar) Weekend() d.Weekend {
+	return calendar.weekend
+}
+
+// HolidaysIn returns the holidays in the date range in date order.
+func (calendar SetCalendar) HolidaysIn(dateRange dr.DateRange) []Holiday {
+	var compare = func(holiday Holiday, date d.Date) int {
+		return int(holiday.Date.Compare(date))
+	}
+	var start, _ = slices.BinarySearchFunc(calendar.holidays, dateRange.First(), compare)
+	var end = start
+	for end < len(calendar.holidays) && !calendar.holidays[end].Date.After(dateRange.Last()) {
+		end++
+	}
+	return slices.Clone(calendar.holidays[start:end])
+}
+
+// HolidayName returns the name of the holiday on the date, if any.
+func (calendar SetCalendar) HolidayName(date d.Date) (string, bool) {
+	var name, found = calendar.names[date]
+	return name, found
+}
+
+// FindHoliday returns the holiday with the name in the year, if any.  Names
+// are matched without regard to case.
+func (calendar SetCalendar) FindHoliday(name string, year d.Year) (Holiday, bool) {
+	for _, holiday := range calendar.holidays {
+		if holiday.Date.Year() == year && strings.EqualFold(holiday.Name, name) {
+			return holiday, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// Holidays returns all the holidays in the calendar in date order.
+func (calendar SetCalendar) Holidays() []Holiday {
+	return slices.Clone(calendar.holidays)
+}
@@ -0,0 +1,504 @@
+// This file performs tests on the calendar package.
+package calendar
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	d "github.com/waysys/waydate/pkg/date"
+	dr "github.com/waysys/waydate/pkg/daterange"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+var newYear, _ = d.New(1, 1, 2024)
+var independence, _ = d.New(7, 4, 2024)
+var christmas, _ = d.New(12, 25, 2024)
+
+var holidays = []Holiday{
+	{"Christmas Day", christmas},
+	{"New Year's Day", newYear},
+	{"Independence Day", independence},
+}
+
+// ----------------------------------------------------------------------------
+// Test Main
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+// ----------------------------------------------------------------------------
+// Support functions
+// ----------------------------------------------------------------------------
+
+// handle checks an error return.  If it is not nil, it calls t.Fatalf to
+// fail the test and print the error.
+func handle(err error, t *testing.T) {
+	if err != nil {
+		t.Fatalf("%s\n", err)
+	}
+}
+
+// newDate creates a date from an ISO 8601 string.
+func newDate(value string, t *testing.T) d.Date {
+	var date, err = d.Parse(d.ISO8601, value)
+	handle(err, t)
+	return date
+}
+
+// ----------------------------------------------------------------------------
+// Test set calendar
+// ----------------------------------------------------------------------------
+
+// Test_NewSetCalendar checks the creation of set calendars.
+func Test_NewSetCalendar(t *testing.T) {
+	var calendar, err = NewSetCalendar("Test", holidays...)
+	handle(err, t)
+	if calendar.Name() != "Test" {
+		t.Errorf("Wrong calendar name: %s", calendar.Name())
+	}
+	var sorted = calendar.Holidays()
+	if sorted[0].Date != newYear || sorted[2].Date != christmas {
+		t.Errorf("Holidays are not in date order: %v", sorted)
+	}
+
+	_, err = NewSetCalendar("Duplicate", Holiday{"A", newYear}, Holiday{"B", newYear})
+	if err == nil {
+		t.Error("NewSetCalendar did not detect duplicate dates")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = NewSetCalendar("Unnamed", Holiday{"", newYear})
+	if err == nil {
+		t.Error("NewSetCalendar did not detect missing name")
+	}
+	_, err = NewSetCalendar("Invalid", Holiday{"Zero", d.Date{}})
+	if err == nil {
+		t.Error("NewSetCalendar did not detect invalid date")
+	}
+}
+
+// Test_IsBusinessDay checks the identification of holidays and business days.
+func Test_IsBusinessDay(t *testing.T) {
+	type aTest struct {
+		name     string
+		date     string
+		holiday  bool
+		business bool
+	}
+	var data = []aTest{
+		{"holiday on weekday", "2024-07-04", true, false},
+		{"ordinary weekday", "2024-07-05", false, true},
+		{"Saturday", "2024-07-06", false, false},
+		{"Sunday", "2024-07-07", false, false},
+	}
+	var calendar, err = NewSetCalendar("Test", holidays...)
+	handle(err, t)
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date = newDate(tt.date, t)
+		if calendar.IsHoliday(date) != tt.holiday {
+			t.Errorf("IsHoliday(%s) should be %t", tt.date, tt.holiday)
+		}
+		if calendar.IsBusinessDay(date) != tt.business {
+			t.Errorf("IsBusinessDay(%s) should be %t", tt.date, tt.business)
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+}
+
+// Test_HolidaysIn checks the selection of holidays in a date range and the
+// lookup of holidays by name.
+func Test_HolidaysIn(t *testing.T) {
+	var calendar, err = NewSetCalendar("Test", holidays...)
+	handle(err, t)
+	var holidayCalendar HolidayCalendar = calendar
+
+	var dateRange, err2 = dr.New(newYear, independence)
+	handle(err2, t)
+	var found = holidayCalendar.HolidaysIn(dateRange)
+	if len(found) != 2 || found[0].Date != newYear || found[1].Date != independence {
+		t.Errorf("Wrong holidays in %s: %v", dateRange, found)
+	}
+
+	dateRange, err = dr.New(newDate("2024-07-05", t), newDate("2024-12-24", t))
+	handle(err, t)
+	found = holidayCalendar.HolidaysIn(dateRange)
+	if len(found) != 0 {
+		t.Errorf("Wrong holidays in %s: %v", dateRange, found)
+	}
+
+	var name, ok = holidayCalendar.HolidayName(christmas)
+	if !ok || name != "Christmas Day" {
+		t.Errorf("Wrong holiday name for %s: %s", christmas, name)
+	}
+	var holiday, ok2 = holidayCalendar.FindHoliday("christmas day", 2024)
+	if !ok2 || holiday.Date != christmas {
+		t.Errorf("FindHoliday did not find Christmas Day")
+	}
+	_, ok2 = holidayCalendar.FindHoliday("Christmas Day", 2023)
+	if ok2 {
+		t.Errorf("FindHoliday found Christmas Day in 2023")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test business-day arithmetic
+// ----------------------------------------------------------------------------
+
+// countBusinessDays counts the business days after from up to and including
+// to one day at a time.
+func countBusinessDays(from d.Date, to d.Date, calendar HolidayCalendar) int {
+	var count = 0
+	var current = from
+	for current.Before(to) {
+		current, _ = current.Increment()
+		if calendar.IsBusinessDay(current) {
+			count++
+		}
+	}
+	return count
+}
+
+// Test_AddBusinessDays checks business-day addition against specific dates.
+func Test_AddBusinessDays(t *testing.T) {
+	type aTest struct {
+		name     string
+		date     string
+		num      int
+		expected string
+	}
+	var data = []aTest{
+		{"T+3 over weekend", "2024-07-03", 3, "2024-07-09"},
+		{"T+1 over holiday", "2024-07-03", 1, "2024-07-05"},
+		{"from Saturday", "2024-07-06", 5, "2024-07-12"},
+		{"T-1 over holiday", "2024-07-05", -1, "2024-07-03"},
+		{"T-5 from Sunday", "2024-07-07", -5, "2024-06-28"},
+		{"zero", "2024-07-06", 0, "2024-07-06"},
+		{"over year end", "2024-12-24", 2, "2024-12-27"},
+		{"long span", "2024-01-01", 250, "2024-12-17"},
+	}
+	var calendar, err = NewSetCalendar("Test", holidays...)
+	handle(err, t)
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var actual, err = AddBusinessDays(newDate(tt.date, t), tt.num, calendar)
+		handle(err, t)
+		if actual != newDate(tt.expected, t) {
+			t.Errorf("AddBusinessDays(%s, %d) returned %s", tt.date, tt.num, actual)
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+
+	_, err = AddBusinessDays(d.MaxDate, 1, calendar)
+	if err == nil {
+		t.Error("AddBusinessDays did not detect date overflow")
+	}
+}
+
+// Test_BusinessDaysBetween checks business-day counting against a count made
+// one day at a time, and checks that it is the inverse of AddBusinessDays.
+func Test_BusinessDaysBetween(t *testing.T) {
+	var calendar, err = NewSetCalendar("Test", holidays...)
+	handle(err, t)
+	var start = newDate("2023-12-20", t)
+
+	for i := 0; i < 40; i++ {
+		var from, _ = d.Add(start, i)
+		for j := 0; j < 400; j += 13 {
+			var to, _ = d.Add(from, j)
+			var expected = countBusinessDays(from, to, calendar)
+			var actual = BusinessDaysBetween(from, to, calendar)
+			if actual != expected {
+				t.Fatalf("BusinessDaysBetween(%s, %s) = %d, not %d", from, to, actual, expected)
+			}
+			var reverse = BusinessDaysBetween(to, from, calendar)
+			var expectedReverse = -expected
+			if calendar.IsBusinessDay(from) {
+				expectedReverse--
+			}
+			if calendar.IsBusinessDay(to) {
+				expectedReverse++
+			}
+			if reverse != expectedReverse {
+				t.Fatalf("BusinessDaysBetween(%s, %s) = %d, not %d", to, from, reverse, expectedReverse)
+			}
+		}
+		for num := -30; num <= 30; num++ {
+			var result, err = AddBusinessDays(from, num, calendar)
+			handle(err, t)
+			if BusinessDaysBetween(from, result, calendar) != num {
+				t.Fatalf("AddBusinessDays(%s, %d) = %s is not the inverse of BusinessDaysBetween",
+					from, num, result)
+			}
+		}
+	}
+
+	var dateRange, err2 = dr.New(newDate("2024-07-01", t), newDate("2024-07-07", t))
+	handle(err2, t)
+	if BusinessDaysIn(dateRange, calendar) != 4 {
+		t.Errorf("Wrong number of business days in %s: %d", dateRange, BusinessDaysIn(dateRange, calendar))
+	}
+}
+
+// Test_BusinessDaysWeekend checks business-day arithmetic with weekends
+// other than Saturday and Sunday.
+func Test_BusinessDaysWeekend(t *testing.T) {
+	type aTest struct {
+		name    string
+		weekend d.Weekend
+	}
+	var data = []aTest{
+		{"Friday and Saturday", d.FridaySaturday},
+		{"Sunday only", d.SundayOnly},
+		{"no weekend", d.Weekend{}},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var calendar, err = NewSetCalendarWithWeekend("Test", tt.weekend, holidays...)
+		handle(err, t)
+		var start = newDate("2024-06-25", t)
+		for i := 0; i < 14; i++ {
+			var from, _ = d.Add(start, i)
+			for num := -20; num <= 20; num++ {
+				var result, err = AddBusinessDays(from, num, calendar)
+				handle(err, t)
+				if num > 0 && countBusinessDays(from, result, calendar) != num {
+					t.Fatalf("AddBusinessDays(%s, %d) = %s is wrong", from, num, result)
+				}
+				if num != 0 && !calendar.IsBusinessDay(result) {
+					t.Fatalf("AddBusinessDays(%s, %d) = %s is not a business day", from, num, result)
+				}
+				if BusinessDaysBetween(from, result, calendar) != num {
+					t.Fatalf("BusinessDaysBetween(%s, %s) != %d", from, result, num)
+				}
+			}
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+
+	var calendar, err = NewSetCalendarWithWeekend("Gulf", d.FridaySaturday, holidays...)
+	handle(err, t)
+	if calendar.IsBusinessDay(newDate("2024-07-05", t)) {
+		t.Error("Friday should not be a business day")
+	}
+	if !calendar.IsBusinessDay(newDate("2024-07-07", t)) {
+		t.Error("Sunday should be a business day")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test rule calendars
+// ----------------------------------------------------------------------------
+
+// Test_USFederal checks the observed United States federal holidays in
+// selected years.
+func Test_USFederal(t *testing.T) {
+	type aTest struct {
+		name     string
+		year     d.Year
+		observed []string
+	}
+	var data = []aTest{
+		{"2024", 2024, []string{"2024-01-01", "2024-01-15", "2024-02-19", "2024-05-27", "2024-06-19",
+			"2024-07-04", "2024-09-02", "2024-10-14", "2024-11-11", "2024-11-28", "2024-12-25"}},
+		{"2021 weekend shifts", 2021, []string{"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31",
+			"2021-06-18", "2021-07-05", "2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25",
+			"2021-12-24", "2021-12-31"}},
+		{"2022 no New Year's Day", 2022, []string{"2022-01-17", "2022-02-21", "2022-05-30", "2022-06-20",
+			"2022-07-04", "2022-09-05", "2022-10-10", "2022-11-11", "2022-11-24", "2022-12-26"}},
+		{"1975 Veterans Day in October", 1975, []string{"1975-01-01", "1975-02-17", "1975-05-26",
+			"1975-07-04", "1975-09-01", "1975-10-13", "1975-10-27", "1975-11-27", "1975-12-25"}},
+		{"1970 Saturday not moved", 1970, []string{"1970-01-01", "1970-02-23", "1970-05-30",
+			"1970-07-04", "1970-09-07", "1970-10-12", "1970-11-11", "1970-11-26", "1970-12-25"}},
+		{"1939 Thanksgiving", 1939, []string{"1939-01-02", "1939-02-22", "1939-05-30", "1939-07-04",
+			"1939-09-04", "1939-10-12", "1939-11-11", "1939-11-23", "1939-12-25"}},
+		{"1938 Thanksgiving", 1938, []string{"1938-01-01", "1938-02-22", "1938-05-30", "1938-07-04",
+			"1938-09-05", "1938-10-12", "1938-11-11", "1938-11-24", "1938-12-26"}},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var holidays = USFederal.HolidaysInYear(tt.year)
+		if len(holidays) != len(tt.observed) {
+			t.Fatalf("Year %d has %d holidays, not %d: %v", tt.year, len(holidays), len(tt.observed), holidays)
+		}
+		for index, holiday := range holidays {
+			if holiday.Date != newDate(tt.observed[index], t) {
+				t.Fatalf("Holiday %s is observed on %s, not %s", holiday.Name, holiday.Date, tt.observed[index])
+			}
+			if !USFederal.IsHoliday(holiday.Date) || USFederal.IsBusinessDay(holiday.Date) {
+				t.Fatalf("Holiday %s on %s is not reported as a holiday", holiday.Name, holiday.Date)
+			}
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+}
+
+// Test_RuleCalendar checks the queries of a rule calendar.
+func Test_RuleCalendar(t *testing.T) {
+	var holiday, found = USFederal.FindHoliday("new year's day", 2022)
+	if !found || holiday.Date != newDate("2021-12-31", t) {
+		t.Errorf("New Year's Day 2022 is observed on %s", holiday.Date)
+	}
+	_, found = USFederal.FindHoliday(USMartinLutherKing, 1985)
+	if found {
+		t.Error("Birthday of Martin Luther King, Jr. was not a holiday in 1985")
+	}
+	_, found = USFederal.FindHoliday(USChristmasDay, 1884)
+	if found {
+		t.Error("Christmas Day was not a nationwide federal holiday in 1884")
+	}
+	holiday, found = USFederal.FindHoliday(USChristmasDay, 1885)
+	if !found || holiday.Date != newDate("1885-12-25", t) {
+		t.Errorf("Christmas Day 1885 is observed on %s", holiday.Date)
+	}
+	var name, _ = USFederal.HolidayName(newDate("2021-06-18", t))
+	if name != USJuneteenth {
+		t.Errorf("Wrong holiday on 18-Jun-2021: %s", name)
+	}
+
+	var dateRange, err = dr.New(newDate("2021-12-20", t), newDate("2022-01-20", t))
+	handle(err, t)
+	var holidays = USFederal.HolidaysIn(dateRange)
+	if len(holidays) != 3 || holidays[0].Name != USChristmasDay || holidays[1].Name != USNewYearsDay {
+		t.Errorf("Wrong holidays in %s: %v", dateRange, holidays)
+	}
+
+	var result d.Date
+	result, err = AddBusinessDays(newDate("2021-07-02", t), 1, USFederal)
+	handle(err, t)
+	if result != newDate("2021-07-06", t) {
+		t.Errorf("Business day after 2-Jul-2021 is %s", result)
+	}
+	dateRange, err = dr.New(newDate("2024-01-01", t), newDate("2024-12-31", t))
+	handle(err, t)
+	if BusinessDaysIn(dateRange, USFederal) != 251 {
+		t.Errorf("2024 has %d business days, not 251", BusinessDaysIn(dateRange, USFederal))
+	}
+
+	var rule = HolidayRule{"Bad", 2025, 2024, Fixed(1, 1), ACTUAL}
+	_, err = NewRuleCalendar("Bad", d.SaturdaySunday, rule)
+	if err == nil {
+		t.Error("NewRuleCalendar did not detect a first year after the last year")
+	} else {
+		fmt.Println(err)
+	}
+	rule = HolidayRule{"Fifth Monday", 2024, 2024, NthWeekDay(5, d.MONDAY, 2), ACTUAL}
+	var calendar RuleCalendar
+	calendar, err = NewRuleCalendar("Test", d.SaturdaySunday, rule)
+	handle(err, t)
+	if len(calendar.HolidaysInYear(2024)) != 0 {
+		t.Error("February 2024 does not have a fifth Monday")
+	}
+}
+
+// Test_RuleCalendarWeekend checks the observance of holidays with weekends
+// other than Saturday and Sunday.
+func Test_RuleCalendarWeekend(t *testing.T) {
+	type aTest struct {
+		name       string
+		weekend    d.Weekend
+		month      d.Month
+		day        d.Day
+		observance Observance
+		observed   string
+	}
+	var data = []aTest{
+		{"Saturday nearest", d.SaturdaySunday, 5, 2, NEAREST, "2026-05-01"},
+		{"Saturday following", d.SaturdaySunday, 5, 2, FOLLOWING, "2026-05-02"},
+		{"Friday nearest", d.FridaySaturday, 5, 1, NEAREST, "2026-04-30"},
+		{"Friday following", d.FridaySaturday, 5, 1, FOLLOWING, "2026-05-01"},
+		{"Saturday nearest in Gulf", d.FridaySaturday, 5, 2, NEAREST, "2026-05-03"},
+		{"Saturday following in Gulf", d.FridaySaturday, 5, 2, FOLLOWING, "2026-05-03"},
+		{"Sunday actual in Gulf", d.FridaySaturday, 5, 3, NEAREST, "2026-05-03"},
+		{"Sunday only nearest", d.SundayOnly, 5, 3, NEAREST, "2026-05-04"},
+		{"Sunday only following", d.SundayOnly, 5, 3, FOLLOWING, "2026-05-04"},
+		{"Friday actual", d.FridaySaturday, 5, 1, ACTUAL, "2026-05-01"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var rule = HolidayRule{"Test Day", 2000, 2100, Fixed(tt.month, tt.day), tt.observance}
+		var calendar, err = NewRuleCalendar("Test", tt.weekend, rule)
+		handle(err, t)
+		var holiday, found = calendar.FindHoliday("Test Day", 2026)
+		if !found || holiday.Date != newDate(tt.observed, t) {
+			t.Fatalf("Holiday on %d/%d/2026 is observed on %s, not %s", tt.month, tt.day, holiday.Date, tt.observed)
+		}
+		if !calendar.IsHoliday(holiday.Date) {
+			t.Fatalf("IsHoliday(%s) should be true", holiday.Date)
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+}
+
+// Test_RuleCalendarCache checks that the holidays kept by a rule calendar
+// agree with a set calendar holding the same holidays, including when the
+// calendar is used concurrently.
+func Test_RuleCalendarCache(t *testing.T) {
+	var calendar, err = NewRuleCalendar("US Federal", d.SaturdaySunday, usFederalRules...)
+	handle(err, t)
+	var holidays []Holiday
+	for year := d.Year(1999); year <= 2031; year++ {
+		holidays = append(holidays, calendar.HolidaysInYear(year)...)
+	}
+	var setCalendar SetCalendar
+	setCalendar, err = NewSetCalendar("US Federal", holidays...)
+	handle(err, t)
+
+	var dateRange, err2 = dr.New(newDate("2000-01-01", t), newDate("2030-12-31", t))
+	handle(err2, t)
+	var expected = BusinessDaysIn(dateRange, setCalendar)
+	var results = make(chan int, 4)
+	for i := 0; i < cap(results); i++ {
+		go func() {
+			results <- BusinessDaysIn(dateRange, calendar)
+		}()
+	}
+	for i := 0; i < cap(results); i++ {
+		var actual = <-results
+		if actual != expected {
+			t.Errorf("Rule calendar has %d business days in %s, not %d", actual, dateRange, expected)
+		}
+	}
+
+	var holidaysIn = calendar.HolidaysIn(dateRange)
+	holidaysIn[0].Name = "Changed"
+	if calendar.HolidaysIn(dateRange)[0].Name == "Changed" {
+		t.Error("HolidaysIn returned the holidays kept by the calendar")
+	}
+	var yearHolidays = calendar.HolidaysInYear(2024)
+	yearHolidays[0].Name = "Changed"
+	if calendar.HolidaysInYear(2024)[0].Name == "Changed" {
+		t.Error("HolidaysInYear returned the holidays kept by the calendar")
+	}
+}
@@ -0,0 +1,306 @@
+package calendar
+
+// This file implements holiday calendars defined by rules.  A rule computes
+// the date of a holiday in each year in which the holiday is in effect and
+// moves the holiday to a working day when it falls on a weekend day of the
+// calendar.  The date on
+// which a holiday is observed is the non-working day reported by the
+// calendar.  The holidays observed in each year are computed once and kept
+// by the calendar, since business-day arithmetic looks up every date.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	d "github.com/waysys/waydate/pkg/date"
+	dr "github.com/waysys/waydate/pkg/daterange"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Observance specifies the day on which a holiday falling on a weekend day
+// is observed.
+type Observance int
+
+// DateRule computes the date of a holiday in a year.
+type DateRule func(year d.Year) (d.Date, error)
+
+// HolidayRule defines a holiday that is in effect from the first year
+// through the last year.
+type HolidayRule struct {
+	Name       string
+	FirstYear  d.Year
+	LastYear   d.Year
+	Date       DateRule
+	Observance Observance
+}
+
+// RuleCalendar is a holiday calendar whose holidays are computed from rules.
+type RuleCalendar struct {
+	name    string
+	weekend d.Weekend
+	rules   []HolidayRule
+	cache   *holidayCache
+}
+
+// yearHolidays are the holidays observed in a year in date order and their
+// names by date.
+type yearHolidays struct {
+	holidays []Holiday
+	names    map[d.Date]string
+}
+
+// holidayCache holds the holidays observed in each year for which they have
+// been computed.  It is shared by copies of a calendar and is safe for
+// concurrent use.
+type holidayCache struct {
+	mutex sync.Mutex
+	years map[d.Year]yearHolidays
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// ACTUAL observes a holiday on its date.
+	ACTUAL Observance = 0
+	// NEAREST observes a weekend holiday on the nearest work day, or on the
+	// work day after if the work days before and after are equally near.
+	// With a Saturday and Sunday weekend, a Saturday holiday is observed on
+	// the Friday before and a Sunday holiday on the Monday after.
+	NEAREST Observance = 1
+	// FOLLOWING observes a weekend holiday on the work day after if it is
+	// at least as near as the work day before, and otherwise does not move
+	// the holiday.  With a Saturday and Sunday weekend, a Sunday holiday is
+	// observed on the Monday after and a Saturday holiday is not moved.
+	FOLLOWING Observance = 2
+)
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewRuleCalendar creates a holiday calendar with the specified weekend and
+// holiday rules.  Each rule must have a name, a date rule, a valid
+// observance, and a first year that is not after its last year.
+func NewRuleCalendar(name string, weekend d.Weekend, rules ...HolidayRule) (RuleCalendar, error) {
+	for _, rule := range rules {
+		var err = isHolidayRule(rule)
+		if err != nil {
+			return RuleCalendar{}, errors.New("calendar.NewRuleCalendar: " + err.Error())
+		}
+	}
+	var calendar = RuleCalendar{
+		name:    name,
+		weekend: weekend,
+		rules:   append([]HolidayRule(nil), rules...),
+		cache:   &holidayCache{years: make(map[d.Year]yearHolidays)},
+	}
+	return calendar, nil
+}
+
+// ----------------------------------------------------------------------------
+// Date rules
+// ----------------------------------------------------------------------------
+
+// Fixed returns a date rule for a holiday on the same month and day each
+// year.
+func Fixed(month d.Month, day d.Day) DateRule {
+	return func(year d.Year) (d.Date, error) {
+		return d.New(month, day, year)
+	}
+}
+
+// NthWeekDay returns a date rule for a holiday on the nth day of the week
+// in the month, for example the third Monday in January.  If n is negative,
+// it counts from the end of the month, so -1 is the last day of the week in
+// the month.
+func NthWeekDay(n int, dayOfWeek d.DayOfWeek, month d.Month) DateRule {
+	return func(year d.Year) (d.Date, error) {
+		return d.NthWeekDayOfMonth(n, dayOfWeek, month, year)
+	}
+}
+
+// isObservance returns an error if the observance is not valid.
+func isObservance(observance Observance) error {
+	var err error = nil
+	if observance < ACTUAL || observance > FOLLOWING {
+		err = errors.New("invalid observance: " + strconv.Itoa(int(observance)))
+	}
+	return err
+}
+
+// isHolidayRule returns an error if the rule is not valid.
+func isHolidayRule(rule HolidayRule) error {
+	var err error
+	switch {
+	case strings.TrimSpace(rule.Name) == "":
+		err = errors.New("holiday rule must have a name")
+	case rule.Date == nil:
+		err = errors.New("holiday rule " + rule.Name + " must have a date rule")
+	case rule.FirstYear > rule.LastYear:
+		err = errors.New("holiday rule " + rule.Name + " has first year " + strconv.Itoa(int(rule.FirstYear)) +
+			" after last year " + strconv.Itoa(int(rule.LastYear)))
+	default:
+		err = isObservance(rule.Observance)
+	}
+	return err
+}
+
+// observe returns the date on which a holiday on the date is observed with
+// the weekend.
+func observe(date d.Date, observance Observance, weekend d.Weekend) (d.Date, error) {
+	if observance == ACTUAL || weekend.IsWorkDay(date) {
+		return date, nil
+	}
+	var before, err = addWorkDays(date, -1, weekend)
+	if err != nil {
+		return date, err
+	}
+	var after d.Date
+	after, err = addWorkDays(date, 1, weekend)
+	if err != nil {
+		return date, err
+	}
+	switch {
+	case d.Difference(after, date) <= d.Difference(date, before):
+		return after, nil
+	case observance == NEAREST:
+		return before, nil
+	}
+	return date, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - HolidayRule
+// ----------------------------------------------------------------------------
+
+// Holiday returns the holiday of the rule in the year as observed with the
+// weekend.  It returns false if the rule is not in effect in the year or the
+// date cannot be computed.  The observed date may be in the previous or next
+// year.
+func (rule HolidayRule) Holiday(year d.Year, weekend d.Weekend) (Holiday, bool) {
+	if year < rule.FirstYear || year > rule.LastYear {
+		return Holiday{}, false
+	}
+	var date, err = rule.Date(year)
+	if err == nil {
+		date, err = observe(date, rule.Observance, weekend)
+	}
+	if err != nil {
+		return Holiday{}, false
+	}
+	return Holiday{Name: rule.Name, Date: date}, true
+}
+
+// ----------------------------------------------------------------------------
+// Methods - RuleCalendar
+// ----------------------------------------------------------------------------
+
+// HolidaysInYear returns the holidays observed in the year in date order.  A
+// holiday observed in the year may belong to the year before or after.
+func (calendar RuleCalendar) HolidaysInYear(year d.Year) []Holiday {
+	return slices.Clone(calendar.observed(year).holidays)
+}
+
+// observed returns the holidays observed in the year, computing them the
+// first time the year is requested.
+func (calendar RuleCalendar) observed(year d.Year) yearHolidays {
+	if calendar.cache == nil {
+		return calendar.computeYear(year)
+	}
+	calendar.cache.mutex.Lock()
+	defer calendar.cache.mutex.Unlock()
+	var entry, found = calendar.cache.years[year]
+	if !found {
+		entry = calendar.computeYear(year)
+		calendar.cache.years[year] = entry
+	}
+	return entry
+}
+
+// computeYear computes the holidays observed in the year from the rules.
+func (calendar RuleCalendar) computeYear(year d.Year) yearHolidays {
+	var entry = yearHolidays{names: make(map[d.Date]string)}
+	for holidayYear := year - 1; holidayYear <= year+1; holidayYear++ {
+		for _, rule := range calendar.rules {
+			var holiday, ok = rule.Holiday(holidayYear, calendar.weekend)
+			if ok && holiday.Date.Year() == year {
+				entry.holidays = append(entry.holidays, holiday)
+			}
+		}
+	}
+	sortHolidays(entry.holidays)
+	for _, holiday := range entry.holidays {
+		var _, found = entry.names[holiday.Date]
+		if !found {
+			entry.names[holiday.Date] = holiday.Name
+		}
+	}
+	return entry
+}
+
+// Name returns the name of the calendar.
+func (calendar RuleCalendar) Name() string {
+	return calendar.name
+}
+
+// IsHoliday returns true if a holiday is observed on the date.
+func (calendar RuleCalendar) IsHoliday(date d.Date) bool {
+	var _, found = calendar.HolidayName(date)
+	return found
+}
+
+// IsBusinessDay returns true if the date is neither a weekend day nor a holiday.
+func (calendar RuleCalendar) IsBusinessDay(date d.Date) bool {
+	return calendar.weekend.IsWorkDay(date) && !calendar.IsHoliday(date)
+}
+
+// Weekend returns the days of the week that are not business days.
+func (calendar RuleCalendar) Weekend() d.Weekend {
+	return calendar.weekend
+}
+
+// HolidaysIn returns the holidays observed in the date range in date order.
+func (calendar RuleCalendar) HolidaysIn(dateRange dr.DateRange) []Holiday {
+	var holidays []Holiday
+	for year := dateRange.First().Year(); year <= dateRange.Last().Year(); year++ {
+		for _, holiday := range calendar.observed(year).holidays {
+			if dateRange.InRange(holiday.Date) {
+				holidays = append(holidays, holiday)
+			}
+		}
+	}
+	return holidays
+}
+
+// HolidayName returns the name of the holiday observed on the date, if any.
+func (calendar RuleCalendar) HolidayName(date d.Date) (string, bool) {
+	var name, found = calendar.observed(date.Year()).names[date]
+	return name, found
+}
+
+// FindHoliday returns the holiday with the name in the year, if any.  Names
+// are matched without regard to case.  The date of the holiday is the date
+// on which it is observed, which may be in the previous or next year.
+func (calendar RuleCalendar) FindHoliday(name string, year d.Year) (Holiday, bool) {
+	for _, rule := range calendar.rules {
+		if strings.EqualFold(rule.Name, name) {
+			var holiday, ok = rule.Holiday(year, calendar.weekend)
+			if ok {
+				return holiday, true
+			}
+		}
+	}
+	return Holiday{}, false
+}
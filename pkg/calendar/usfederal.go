@@ -0,0 +1,83 @@
+package calendar
+
+// This file implements the calendar of United States federal holidays set by
+// 5 U.S.C. 6103 and its predecessors.  Each holiday is in effect from the year
+// it was first observed by federal employees nationwide, and holidays whose
+// dates were changed by the Uniform Monday Holiday Act have one rule for each
+// period.  The holidays set for the District of Columbia in 1870 and 1879
+// were extended to federal employees nationwide in 1885.
+//
+// Since Executive Order 11582 took effect in 1971, a holiday falling on a
+// Saturday is observed on the Friday before and a holiday falling on a Sunday
+// is observed on the Monday after.  Before 1971, only Sunday holidays were
+// moved.  New Year's Day falling on a Saturday is therefore observed on
+// 31 December of the previous year.
+//
+// Thanksgiving Day was set by presidential proclamation until 1941.  The
+// rules follow the proclamations: the last Thursday in November through 1938
+// and the second-to-last Thursday from 1939 through 1941.  Inauguration Day,
+// which is a holiday only in the Washington, D.C. area, is not included.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	d "github.com/waysys/waydate/pkg/date"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Names of the United States federal holidays.
+const (
+	USNewYearsDay      = "New Year's Day"
+	USMartinLutherKing = "Birthday of Martin Luther King, Jr."
+	USWashington       = "Washington's Birthday"
+	USMemorialDay      = "Memorial Day"
+	USJuneteenth       = "Juneteenth National Independence Day"
+	USIndependenceDay  = "Independence Day"
+	USLaborDay         = "Labor Day"
+	USColumbusDay      = "Columbus Day"
+	USVeteransDay      = "Veterans Day"
+	USThanksgivingDay  = "Thanksgiving Day"
+	USChristmasDay     = "Christmas Day"
+)
+
+// usNationwideHolidays is the first year in which the holidays set for the
+// District of Columbia are observed by federal employees nationwide.
+const usNationwideHolidays = 1885
+
+// usObservanceChanges is the first year in which Saturday holidays are
+// observed on Friday and the Uniform Monday Holiday Act is in effect.
+const usObservanceChanges = 1971
+
+// usFederalRules are the rules of the United States federal holidays.
+var usFederalRules = []HolidayRule{
+	{USNewYearsDay, usNationwideHolidays, usObservanceChanges - 1, Fixed(1, 1), FOLLOWING},
+	{USNewYearsDay, usObservanceChanges, d.MaxYear, Fixed(1, 1), NEAREST},
+	{USMartinLutherKing, 1986, d.MaxYear, NthWeekDay(3, d.MONDAY, 1), ACTUAL},
+	{USWashington, usNationwideHolidays, usObservanceChanges - 1, Fixed(2, 22), FOLLOWING},
+	{USWashington, usObservanceChanges, d.MaxYear, NthWeekDay(3, d.MONDAY, 2), ACTUAL},
+	{USMemorialDay, 1888, usObservanceChanges - 1, Fixed(5, 30), FOLLOWING},
+	{USMemorialDay, usObservanceChanges, d.MaxYear, NthWeekDay(-1, d.MONDAY, 5), ACTUAL},
+	{USJuneteenth, 2021, d.MaxYear, Fixed(6, 19), NEAREST},
+	{USIndependenceDay, usNationwideHolidays, usObservanceChanges - 1, Fixed(7, 4), FOLLOWING},
+	{USIndependenceDay, usObservanceChanges, d.MaxYear, Fixed(7, 4), NEAREST},
+	{USLaborDay, 1894, d.MaxYear, NthWeekDay(1, d.MONDAY, 9), ACTUAL},
+	{USColumbusDay, 1937, usObservanceChanges - 1, Fixed(10, 12), FOLLOWING},
+	{USColumbusDay, usObservanceChanges, d.MaxYear, NthWeekDay(2, d.MONDAY, 10), ACTUAL},
+	{USVeteransDay, 1938, usObservanceChanges - 1, Fixed(11, 11), FOLLOWING},
+	{USVeteransDay, usObservanceChanges, 1977, NthWeekDay(4, d.MONDAY, 10), ACTUAL},
+	{USVeteransDay, 1978, d.MaxYear, Fixed(11, 11), NEAREST},
+	{USThanksgivingDay, usNationwideHolidays, 1938, NthWeekDay(-1, d.THURSDAY, 11), ACTUAL},
+	{USThanksgivingDay, 1939, 1941, NthWeekDay(-2, d.THURSDAY, 11), ACTUAL},
+	{USThanksgivingDay, 1942, d.MaxYear, NthWeekDay(4, d.THURSDAY, 11), ACTUAL},
+	{USChristmasDay, usNationwideHolidays, usObservanceChanges - 1, Fixed(12, 25), FOLLOWING},
+	{USChristmasDay, usObservanceChanges, d.MaxYear, Fixed(12, 25), NEAREST},
+}
+
+// USFederal is the calendar of United States federal holidays with a Saturday
+// and Sunday weekend.
+var USFederal, _ = NewRuleCalendar("US Federal", d.SaturdaySunday, usFederalRules...)
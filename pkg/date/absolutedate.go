@@ -24,9 +24,12 @@ import (
 // Types
 // ----------------------------------------------------------------------------
 
-// AbsoluteDate is the number of days from 31-Dec-1600.
+// AbsoluteDate is the number of days from 31-Dec-1600.  Dates before
+// 1-Jan-1601, which are created in the PROLEPTIC mode, have an absolute
+// date of zero or less.
 //
-// absoluteDate >= 1 and absoluteDate <= 876216
+// absoluteDate >= 1 and absoluteDate <= 876216, or
+// absoluteDate >= -4236812 and absoluteDate <= 3067671 in the PROLEPTIC mode
 type AbsoluteDate int
 
 // ----------------------------------------------------------------------------
@@ -39,6 +42,12 @@ const MinAbsoluteDate AbsoluteDate = 1
 // MaxAbsoluteDate is the absolute date for 31-Dec-3999
 const MaxAbsoluteDate AbsoluteDate = 876216
 
+// MinDayNumber and MaxDayNumber are the day numbers of MinDate and MaxDate.
+const (
+	MinDayNumber = int(MinAbsoluteDate)
+	MaxDayNumber = int(MaxAbsoluteDate)
+)
+
 const (
 	daysIn400YearCycle = 400*365 + 100 - 3
 	daysIn100YearCycle = 365*100 + 25 - 1
@@ -50,10 +59,11 @@ const (
 // Functions
 // ----------------------------------------------------------------------------
 
-// isAbsoluteDate returns an error if the absolute date is not valid.
-func isAbsoluteDate(absoluteDate AbsoluteDate) error {
+// isAbsoluteDate returns an error if the absolute date is not valid in the
+// range of the mode.
+func (mode Mode) isAbsoluteDate(absoluteDate AbsoluteDate) error {
 	var err error = nil
-	if absoluteDate < MinAbsoluteDate || absoluteDate > MaxAbsoluteDate {
+	if int(absoluteDate) < mode.MinDayNumber() || int(absoluteDate) > mode.MaxDayNumber() {
 		err = errors.New("isAbsoluteDate: invalid absolute date: " + strconv.Itoa(int(absoluteDate)))
 	}
 	return err
@@ -74,18 +84,22 @@ func convertToAbsolute(date Date) (AbsoluteDate, error) {
 	var absoluteDate = AbsoluteDate(pastDays + dayYear)
 
 	// Postcondition:
-	assert.Assert(1 <= absoluteDate && absoluteDate <= MaxAbsoluteDate,
-		"convertToAbsolute: Absolute date is outside of bounds: "+strconv.Itoa(int(absoluteDate)))
+	//   The message is only built on failure so that the conversion does not
+	//   allocate memory.
+	if absoluteDate < ProlepticMinDayNumber || absoluteDate > ProlepticMaxDayNumber {
+		assert.Assert(false,
+			"convertToAbsolute: Absolute date is outside of bounds: "+strconv.Itoa(int(absoluteDate)))
+	}
 	return absoluteDate, nil
 }
 
 // ConvertToDate converts an absolute date to a date (month, day, year)
 func convertToDate(absoluteDate AbsoluteDate) (Date, error) {
-	assert.Precondition(isAbsoluteDate(absoluteDate))
+	assert.Precondition(PROLEPTIC.isAbsoluteDate(absoluteDate))
 
 	var year = yearFromAbsolute(absoluteDate)
 	var dayOfYear = DayOfYear(int(absoluteDate) - daysInPastYears(year))
-	var date, err = FromDayOfYear(dayOfYear, year)
+	var date, err = PROLEPTIC.FromDayOfYear(dayOfYear, year)
 
 	var postCondition = func() error {
 		var err error
@@ -108,10 +122,14 @@ func convertToDate(absoluteDate AbsoluteDate) (Date, error) {
 // This algorithm is from:
 // Edward M. Reingold and Nachum Dershowitz, Calendrical Calculations:
 // The Millennium Edition (Cambridge, UK: Cambridge University Press, 2001)
+//
+// The number of 400-year cycles is rounded toward negative infinity, so the
+// remainder is never negative and the algorithm also applies to the years
+// before 1601.
 func yearFromAbsolute(absoluteDate AbsoluteDate) Year {
 	var year Year
-	var num400YearCycles = (absoluteDate - 1) / daysIn400YearCycle
-	var remainder100 = (absoluteDate - 1) % daysIn400YearCycle
+	var num400YearCycles = floorDiv(int(absoluteDate)-1, daysIn400YearCycle)
+	var remainder100 = int(absoluteDate) - 1 - num400YearCycles*daysIn400YearCycle
 	var num100YearCycles = remainder100 / daysIn100YearCycle
 	var remainder4 = remainder100 % daysIn100YearCycle
 	var num4YearCycles = remainder4 / daysIn4YearCycle
@@ -128,7 +146,9 @@ func yearFromAbsolute(absoluteDate AbsoluteDate) Year {
 // daysInPastYears computes the number of days starting in 1-Jan-1601 and ending
 // in 31-Dec-year-1.
 //
-// Note that daysInPastYears(MinYear) = 0.
+// Note that daysInPastYears(1601) = 0 and that the result is negative for
+// years before 1601.  The divisions are rounded toward negative infinity so
+// that the leap days of years before 1601 are subtracted.
 //
 // This algorithm is from:
 // Edward M. Reingold and Nachum Dershowitz, Calendrical Calculations:
@@ -136,8 +156,45 @@ func yearFromAbsolute(absoluteDate AbsoluteDate) Year {
 func daysInPastYears(year Year) int {
 	var y = int(year) - 1601
 	var result = 365*y + // days in prior years if all years had 365 days
-		y/4 - // plus julian leap days in prior years if all years divided by 4 were leap years
-		y/100 + // minus prior century years if all years divisible by 100 were not leap years
-		y/400 // plus years divisible by 400 which have leap days
+		floorDiv(y, 4) - // plus julian leap days in prior years if all years divided by 4 were leap years
+		floorDiv(y, 100) + // minus prior century years if all years divisible by 100 were not leap years
+		floorDiv(y, 400) // plus years divisible by 400 which have leap days
 	return result
 }
+
+// ----------------------------------------------------------------------------
+// Day numbers
+// ----------------------------------------------------------------------------
+
+// DayNumber returns the day number of the date, which is the number of days
+// since 31-Dec-1600.  The epoch is fixed: 1-Jan-1601 is day number 1 and each
+// following date is one more, so DayNumber(date2) - DayNumber(date1) =
+// Difference(date2, date1).  Every date from MinDate through MaxDate has a
+// day number from MinDayNumber through MaxDayNumber, which makes day numbers
+// suitable as indexes into arrays and bitmaps.  A date created in the
+// PROLEPTIC mode has a day number from ProlepticMinDayNumber through
+// ProlepticMaxDayNumber, which is zero or less before 1-Jan-1601.  The day
+// number is the absolute date.
+func (date Date) DayNumber() int {
+	var absoluteDate, err = convertToAbsolute(date)
+	assert.Precondition(err)
+	return int(absoluteDate)
+}
+
+// FromDayNumber returns the date with the day number.  An error is returned
+// if the day number is not from MinDayNumber through MaxDayNumber.
+func FromDayNumber(dayNumber int) (Date, error) {
+	return STANDARD.FromDayNumber(dayNumber)
+}
+
+// FromDayNumber returns the date with the day number.  An error is returned
+// if the day number is outside the range of the mode.
+func (mode Mode) FromDayNumber(dayNumber int) (Date, error) {
+	var err = mode.isAbsoluteDate(AbsoluteDate(dayNumber))
+	if err != nil {
+		var message = "date.FromDayNumber: day number must be from " + strconv.Itoa(mode.MinDayNumber()) +
+			" through " + strconv.Itoa(mode.MaxDayNumber()) + ", not " + strconv.Itoa(dayNumber)
+		return Date{}, errors.New(message)
+	}
+	return convertToDate(AbsoluteDate(dayNumber))
+}
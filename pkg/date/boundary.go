@@ -0,0 +1,106 @@
+// ----------------------------------------------------------------------------
+//
+// Boundary
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the first and last dates of the week, month, quarter,
+// and year containing a date.  The boundaries are in the range of the mode
+// of the date: STANDARD for a date from MinDate through MaxDate, and
+// PROLEPTIC for any other valid date.  Months, quarters, and years never
+// extend past the range of a mode, so their boundaries always exist.  A week
+// containing a date near the first or last date of a mode may begin before
+// or end after the range, so the week boundaries return an error in that
+// case.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Week
+// ----------------------------------------------------------------------------
+
+// StartOfWeek returns the first date of the week containing the date, where
+// the week begins on the specified day of the week.
+func (date Date) StartOfWeek(firstDayOfWeek DayOfWeek) (Date, error) {
+	assert.Precondition(IsADate(date))
+	assert.Precondition(isDayOfWeek(firstDayOfWeek))
+	var weekDay, _ = date.WeekDay()
+	return modeOf(date).Add(date, -weekDayDifference(firstDayOfWeek, weekDay))
+}
+
+// EndOfWeek returns the last date of the week containing the date, where
+// the week begins on the specified day of the week.
+func (date Date) EndOfWeek(firstDayOfWeek DayOfWeek) (Date, error) {
+	assert.Precondition(IsADate(date))
+	assert.Precondition(isDayOfWeek(firstDayOfWeek))
+	var lastDayOfWeek = DayOfWeek(floorMod(int(firstDayOfWeek)+6, 7))
+	var weekDay, _ = date.WeekDay()
+	return modeOf(date).Add(date, weekDayDifference(weekDay, lastDayOfWeek))
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Month
+// ----------------------------------------------------------------------------
+
+// StartOfMonth returns the first date of the month containing the date.
+func (date Date) StartOfMonth() Date {
+	assert.Precondition(IsADate(date))
+	return Date{month: date.month, day: 1, year: date.year}
+}
+
+// EndOfMonth returns the last date of the month containing the date.
+func (date Date) EndOfMonth() Date {
+	assert.Precondition(IsADate(date))
+	var lastDay, _ = PROLEPTIC.DaysInMonth(date.month, date.year)
+	return Date{month: date.month, day: Day(lastDay), year: date.year}
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Quarter
+// ----------------------------------------------------------------------------
+
+// StartOfQuarter returns the first date of the calendar quarter containing
+// the date.
+func (date Date) StartOfQuarter() Date {
+	assert.Precondition(IsADate(date))
+	var month = (date.month-1)/MonthsInQuarter*MonthsInQuarter + 1
+	return Date{month: month, day: 1, year: date.year}
+}
+
+// EndOfQuarter returns the last date of the calendar quarter containing the
+// date.
+func (date Date) EndOfQuarter() Date {
+	assert.Precondition(IsADate(date))
+	var month = (date.month-1)/MonthsInQuarter*MonthsInQuarter + MonthsInQuarter
+	var lastDay, _ = PROLEPTIC.DaysInMonth(month, date.year)
+	return Date{month: month, day: Day(lastDay), year: date.year}
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Year
+// ----------------------------------------------------------------------------
+
+// StartOfYear returns 1 January of the year containing the date.
+func (date Date) StartOfYear() Date {
+	assert.Precondition(IsADate(date))
+	return Date{month: 1, day: 1, year: date.year}
+}
+
+// EndOfYear returns 31 December of the year containing the date.
+func (date Date) EndOfYear() Date {
+	assert.Precondition(IsADate(date))
+	return Date{month: 12, day: 31, year: date.year}
+}
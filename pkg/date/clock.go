@@ -0,0 +1,140 @@
+// ----------------------------------------------------------------------------
+//
+// Clock
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the clocks that supply the current date.  Code that
+// needs today's date should accept a Clock so that tests can substitute a
+// FakeClock set to a month end, a leap day, or any other date.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"sync"
+	"time"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Clock supplies the current instant.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the clock of the operating system.
+type systemClock struct{}
+
+// FakeClock is a clock that returns a set instant until it is set or
+// advanced.  It is safe for use by multiple goroutines.
+type FakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// SystemClock is the clock that returns time.Now.
+var SystemClock Clock = systemClock{}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// NewFakeClock returns a fake clock set to the instant.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// TodayIn returns the current date in the location.
+//
+// Precondition:
+//
+//	loc != nil
+func TodayIn(loc *time.Location) Date {
+	assert.Precondition(isLocation(loc))
+	return TodayFrom(fixedLocation{SystemClock, loc})
+}
+
+// TodayFrom returns the current date of the clock, in the location of the
+// instant the clock returns.
+//
+// Precondition:
+//
+//	the year of the instant is from MinYear through MaxYear
+func TodayFrom(clock Clock) Date {
+	var date, err = FromTime(clock.Now())
+	assert.Precondition(err)
+	return date
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Now returns the current instant in the local time zone.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Now returns the instant to which the clock is set.
+func (clock *FakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+// Set sets the clock to the instant.
+func (clock *FakeClock) Set(now time.Time) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = now
+}
+
+// Advance moves the clock forward by the duration.  A negative duration
+// moves the clock back.
+func (clock *FakeClock) Advance(duration time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(duration)
+}
+
+// AdvanceDays moves the clock forward by a number of calendar days, keeping
+// the time of day in the location of the clock's instant.  A negative number
+// moves the clock back.
+func (clock *FakeClock) AdvanceDays(num int) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.AddDate(0, 0, num)
+}
+
+// ----------------------------------------------------------------------------
+// Location adapter
+// ----------------------------------------------------------------------------
+
+// fixedLocation is a clock that returns the instants of another clock in a
+// location.
+type fixedLocation struct {
+	clock Clock
+	loc   *time.Location
+}
+
+// Now returns the current instant of the underlying clock in the location.
+func (adapter fixedLocation) Now() time.Time {
+	return adapter.clock.Now().In(adapter.loc)
+}
@@ -0,0 +1,195 @@
+// ----------------------------------------------------------------------------
+//
+// Cutover
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the change from the Julian calendar to the Gregorian
+// calendar.  Each region adopted the Gregorian calendar on its own date and
+// skipped the days by which the Julian calendar had fallen behind.  A
+// historical date written in a region is a Julian date before the cutover and
+// a Gregorian date from the cutover on.  Since most cutovers precede 1601,
+// the dates are created in the PROLEPTIC mode.
+//
+// The cutover handles only the change of calendar.  Other historical
+// practices, such as the English year beginning on 25 March before 1752 or
+// the Swedish calendar of 1700 to 1712, are not handled.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Cutover is the change from the Julian calendar to the Gregorian calendar in
+// a region.  The first Gregorian date immediately follows the last Julian
+// date.
+type Cutover struct {
+	region string
+	first  Date
+}
+
+// CutoverTable maps the names of regions to their cutovers.
+type CutoverTable map[string]Cutover
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewCutover returns the cutover of a region in which the month, day, and
+// year are the first date of the Gregorian calendar.  The Julian calendar
+// must be behind the Gregorian calendar on that date, so that the change
+// skips dates rather than repeats them.
+func NewCutover(region string, month Month, day Day, year Year) (Cutover, error) {
+	var first, err = PROLEPTIC.New(month, day, year)
+	if err != nil {
+		return Cutover{}, errors.New("date.NewCutover: " + err.Error())
+	}
+	var cutover = Cutover{
+		region: region,
+		first:  first,
+	}
+	_, err = JulianFromDayNumber(first.DayNumber() - 1)
+	if err != nil {
+		return Cutover{}, errors.New("date.NewCutover: " + err.Error())
+	}
+	if cutover.DaysSkipped() <= 0 {
+		return Cutover{}, errors.New("date.NewCutover: cutover on " + first.String() +
+			" follows Julian date " + cutover.LastJulian().String() + " and would not skip any dates")
+	}
+	return cutover, nil
+}
+
+// DefaultCutovers returns a new table with the cutovers of the regions
+// below.  The table may be changed without affecting other tables.
+//
+//	Spain, Portugal, Italy, Poland   15-Oct-1582
+//	France                           20-Dec-1582
+//	Prussia                          02-Sep-1610
+//	Denmark, Norway                  01-Mar-1700
+//	Britain                          14-Sep-1752
+//	Sweden                           01-Mar-1753
+//	Bulgaria                         14-Apr-1916
+//	Russia                           14-Feb-1918
+//	Romania                          14-Apr-1919
+//	Greece                           01-Mar-1923
+func DefaultCutovers() CutoverTable {
+	var table = CutoverTable{}
+	var add = func(month Month, day Day, year Year, regions ...string) {
+		for _, region := range regions {
+			table[region], _ = NewCutover(region, month, day, year)
+		}
+	}
+	add(10, 15, 1582, "Spain", "Portugal", "Italy", "Poland")
+	add(12, 20, 1582, "France")
+	add(9, 2, 1610, "Prussia")
+	add(3, 1, 1700, "Denmark", "Norway")
+	add(9, 14, 1752, "Britain")
+	add(3, 1, 1753, "Sweden")
+	add(4, 14, 1916, "Bulgaria")
+	add(2, 14, 1918, "Russia")
+	add(4, 14, 1919, "Romania")
+	add(3, 1, 1923, "Greece")
+	return table
+}
+
+// labelBefore returns true if the first month, day, and year are earlier in
+// the calendar than the second, without regard to which calendar each
+// belongs to.
+func labelBefore(month1 Month, day1 Day, year1 Year, month2 Month, day2 Day, year2 Year) bool {
+	var result bool
+	switch {
+	case year1 != year2:
+		result = year1 < year2
+	case month1 != month2:
+		result = month1 < month2
+	default:
+		result = day1 < day2
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Cutover
+// ----------------------------------------------------------------------------
+
+// Region returns the name of the region of the cutover.
+func (cutover Cutover) Region() string {
+	return cutover.region
+}
+
+// First returns the first date of the Gregorian calendar in the region.
+func (cutover Cutover) First() Date {
+	return cutover.first
+}
+
+// LastJulian returns the last date of the Julian calendar in the region.
+func (cutover Cutover) LastJulian() JulianDate {
+	var lastJulian, _ = JulianFromDayNumber(cutover.first.DayNumber() - 1)
+	return lastJulian
+}
+
+// DaysSkipped returns the number of dates that did not occur in the region,
+// for example 11 for Britain, where 2-Sep-1752 was followed by 14-Sep-1752.
+func (cutover Cutover) DaysSkipped() int {
+	var first = cutover.first
+	return julianDayNumber(first.month, first.day, first.year) - first.DayNumber()
+}
+
+// IsJulian returns true if the date was written in the Julian calendar in
+// the region, that is, if it is before the cutover.
+func (cutover Cutover) IsJulian(date Date) bool {
+	return date.Before(cutover.first)
+}
+
+// Resolve returns the date of a historical date written in the region.  A
+// date before the cutover is a Julian date and a date from the cutover on is
+// a Gregorian date.  An error is returned for a date skipped by the cutover,
+// for a date that does not exist in its calendar, and for a date outside the
+// range of the PROLEPTIC mode.
+func (cutover Cutover) Resolve(month Month, day Day, year Year) (Date, error) {
+	var first = cutover.first
+	if !labelBefore(month, day, year, first.month, first.day, first.year) {
+		return PROLEPTIC.New(month, day, year)
+	}
+	var lastJulian = cutover.LastJulian()
+	if labelBefore(lastJulian.month, lastJulian.day, lastJulian.year, month, day, year) {
+		return Date{}, errors.New("date.Resolve: " + MonthName(month) + " " + strconv.Itoa(int(day)) + ", " +
+			strconv.Itoa(int(year)) + " did not occur in " + cutover.region + " because " +
+			lastJulian.String() + " was followed by " + first.String())
+	}
+	var julianDate, err = NewJulian(month, day, year)
+	if err != nil {
+		return Date{}, err
+	}
+	return julianDate.Date()
+}
+
+// ----------------------------------------------------------------------------
+// Methods - CutoverTable
+// ----------------------------------------------------------------------------
+
+// Resolve returns the date of a historical date written in the region, as
+// in Cutover.Resolve.  An error is returned if the region is not in the
+// table.
+func (table CutoverTable) Resolve(region string, month Month, day Day, year Year) (Date, error) {
+	var cutover, ok = table[region]
+	if !ok {
+		return Date{}, errors.New("date.Resolve: no cutover for region " + strconv.Quote(region))
+	}
+	return cutover.Resolve(month, day, year)
+}
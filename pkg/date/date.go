@@ -24,7 +24,6 @@ import (
 	"errors"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/waysys/assert/assert"
 )
@@ -43,9 +42,11 @@ type Month int
 //	1 <= day <= 31
 type Day int
 
-// Year represents the range of valid years.
+// Year represents the range of valid years.  Years are numbered as in
+// ISO 8601: year 0 is 1 BC and year -1 is 2 BC.
 //
-//	MinYear <= year <= MaxYear
+//	MinYear <= year <= MaxYear, or
+//	ProlepticMinYear <= year <= ProlepticMaxYear in the PROLEPTIC mode
 type Year int
 
 // DayOfYear represents the range of days in a year.
@@ -55,7 +56,8 @@ type DayOfYear int
 
 type Order int
 
-// Date represents a date in the Gregorian calendar
+// Date represents a date in the Gregorian calendar.  A date created in the
+// PROLEPTIC mode may precede the adoption of the calendar.
 type Date struct {
 	month Month
 	day   Day
@@ -113,12 +115,22 @@ func isMonth(month Month) error {
 }
 
 func isYear(year Year) error {
+	return STANDARD.isYear(year)
+}
+
+// isYear returns nil if the year is in the range of the mode.  Otherwise, an
+// error is returned.
+func (mode Mode) isYear(year Year) error {
 	var err error
+	var prefix = ""
+	if mode == PROLEPTIC {
+		prefix = "Proleptic"
+	}
 	switch {
-	case year < MinYear:
-		err = errors.New("Year cannot be less than MinYear: " + strconv.Itoa(int(year)))
-	case year > MaxYear:
-		err = errors.New("Year cannot be greater than MaxYear: " + strconv.Itoa(int(year)))
+	case year < mode.MinYear():
+		err = errors.New("Year cannot be less than " + prefix + "MinYear: " + strconv.Itoa(int(year)))
+	case year > mode.MaxYear():
+		err = errors.New("Year cannot be greater than " + prefix + "MaxYear: " + strconv.Itoa(int(year)))
 	default:
 		err = nil
 	}
@@ -128,7 +140,7 @@ func isYear(year Year) error {
 // isDay return nil if the day is a valid day of the month (1 <= day <= DaysInMonth(month, year).
 // Otherwise, it returns an error.
 func isDay(month Month, day Day, year Year) error {
-	maxDays, err := DaysInMonth(month, year)
+	maxDays, err := PROLEPTIC.DaysInMonth(month, year)
 	switch {
 	case err != nil:
 		break
@@ -145,12 +157,18 @@ func isDay(month Month, day Day, year Year) error {
 // IsDate return nil if the month, day, and year are valid values.  Otherwise, an error is
 // returned.
 func IsDate(month Month, day Day, year Year) error {
+	return STANDARD.IsDate(month, day, year)
+}
+
+// IsDate return nil if the month, day, and year are valid values in the range
+// of the mode.  Otherwise, an error is returned.
+func (mode Mode) IsDate(month Month, day Day, year Year) error {
 	var err error
 	err = isMonth(month)
 	if err != nil {
 		return err
 	}
-	err = isYear(year)
+	err = mode.isYear(year)
 	if err != nil {
 		return err
 	}
@@ -159,15 +177,15 @@ func IsDate(month Month, day Day, year Year) error {
 }
 
 // IsADate returns nil if the date has valid components.  Otherwise, an error is
-// returned.
+// returned.  A date created in the PROLEPTIC mode is valid.
 func IsADate(date Date) error {
-	return IsDate(date.month, date.day, date.year)
+	return PROLEPTIC.IsDate(date.month, date.day, date.year)
 }
 
 // isDayOfYear return nil if the dayOfYear is a valid day of year.  Otherwise, it
 // returns an error.
 func isDayOfYear(dayOfYear DayOfYear, year Year) error {
-	daysInYear, err := DaysInYear(year)
+	daysInYear, err := PROLEPTIC.DaysInYear(year)
 	switch {
 	case err != nil:
 		break
@@ -185,9 +203,15 @@ func isDayOfYear(dayOfYear DayOfYear, year Year) error {
 // ----------------------------------------------------------------------------
 
 // IsLeapYear returns true if the year is a leap year, that is it has 366 days in the year.
-// This function is definitional.
+// The year may be in the range of either mode.  This function is definitional.
 func IsLeapYear(year Year) bool {
-	assert.Precondition(isYear(year))
+	assert.Precondition(PROLEPTIC.isYear(year))
+	return isLeapYear(year)
+}
+
+// isLeapYear returns true if the year is a leap year in the proleptic
+// Gregorian calendar.
+func isLeapYear(year Year) bool {
 	var result bool
 	switch {
 	case year%400 == 0:
@@ -205,6 +229,12 @@ func IsLeapYear(year Year) bool {
 // DaysInMonth returns the number of days in the specified month and year.
 // This function is definitional.
 func DaysInMonth(month Month, year Year) (int, error) {
+	return STANDARD.DaysInMonth(month, year)
+}
+
+// DaysInMonth returns the number of days in the specified month and year in
+// the range of the mode.
+func (mode Mode) DaysInMonth(month Month, year Year) (int, error) {
 	var err error
 	var days int
 
@@ -213,14 +243,14 @@ func DaysInMonth(month Month, year Year) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	err = isYear(year)
+	err = mode.isYear(year)
 	if err != nil {
 		return 0, err
 	}
 
 	// Calculations
 	days = daysInMonth[month-1]
-	if IsLeapYear(year) && (month == 2) {
+	if isLeapYear(year) && (month == 2) {
 		// add a day if it is a leap year and the month is February.
 		days++
 	}
@@ -243,13 +273,19 @@ func DaysInMonth(month Month, year Year) (int, error) {
 
 // DaysInYear returns the number of days in the specified year.
 func DaysInYear(year Year) (int, error) {
+	return STANDARD.DaysInYear(year)
+}
+
+// DaysInYear returns the number of days in the specified year in the range
+// of the mode.
+func (mode Mode) DaysInYear(year Year) (int, error) {
 	var err error
 	var days int
-	err = isYear(year)
+	err = mode.isYear(year)
 	if err != nil {
 		return 0, err
 	}
-	if IsLeapYear(year) {
+	if isLeapYear(year) {
 		days = 366
 	} else {
 		days = 365
@@ -272,7 +308,7 @@ func DayYear(date Date) DayOfYear {
 // the specified month for the specified year.
 func daysInPriorMonths(month Month, year Year) int {
 	assert.Precondition(isMonth(month))
-	assert.Precondition(isYear(year))
+	assert.Precondition(PROLEPTIC.isYear(year))
 
 	// Invariant:
 	//   totalDays = for 1 <= m < month : sum(DaysInMonth(m, year))
@@ -283,7 +319,7 @@ func daysInPriorMonths(month Month, year Year) int {
 	var daysInMonth int
 	// Invariant is true for month = 1
 	for m := 1; m < limit; m++ {
-		daysInMonth, _ = DaysInMonth(Month(m), year)
+		daysInMonth, _ = PROLEPTIC.DaysInMonth(Month(m), year)
 		totalDays += daysInMonth
 		// Invariant true for m < month
 	}
@@ -299,8 +335,14 @@ func daysInPriorMonths(month Month, year Year) int {
 
 // New returns a date based on the specified month, day, and year
 func New(month Month, day Day, year Year) (Date, error) {
+	return STANDARD.New(month, day, year)
+}
+
+// New returns a date based on the specified month, day, and year in the
+// range of the mode.
+func (mode Mode) New(month Month, day Day, year Year) (Date, error) {
 	// Precondition
-	err := IsDate(month, day, year)
+	err := mode.IsDate(month, day, year)
 	if err != nil {
 		return Date{}, err
 	}
@@ -315,15 +357,10 @@ func New(month Month, day Day, year Year) (Date, error) {
 	return date, nil
 }
 
-// Today returns the current date.
+// Today returns the current date in the local time zone.  Use TodayIn for
+// the date in another location, and TodayFrom to supply a clock in tests.
 func Today() Date {
-	now := time.Now()
-	month := now.Month()
-	day := now.Day()
-	year := now.Year()
-	date, _ := New(Month(month), Day(day), Year(year))
-	assert.Postcondition(IsADate(date))
-	return date
+	return TodayFrom(SystemClock)
 }
 
 // FromDayOfYear returns a date based on the day of the year and the specified
@@ -338,8 +375,17 @@ func Today() Date {
 //	date == FromDayOfYear(dayOfYear, year)
 //	DayYear(date) == dayOfYear
 func FromDayOfYear(dayOfYear DayOfYear, year Year) (Date, error) {
+	return STANDARD.FromDayOfYear(dayOfYear, year)
+}
+
+// FromDayOfYear returns a date based on the day of the year and the
+// specified year in the range of the mode.
+func (mode Mode) FromDayOfYear(dayOfYear DayOfYear, year Year) (Date, error) {
 	// Precondition
-	err := isDayOfYear(dayOfYear, year)
+	err := mode.isYear(year)
+	if err == nil {
+		err = isDayOfYear(dayOfYear, year)
+	}
 	if err != nil {
 		return Date{}, err
 	}
@@ -365,7 +411,7 @@ func FromDayOfYear(dayOfYear DayOfYear, year Year) (Date, error) {
 	//    remainingDays == dayOfYear && daysInPastMonths = 0 &&
 	//    remainingDays + daysInPastMonths == dayOfYear
 	for {
-		daysInMonth, _ = DaysInMonth(month, year)
+		daysInMonth, _ = PROLEPTIC.DaysInMonth(month, year)
 		if remainingDays > daysInMonth {
 			month++
 			daysInPastMonths += daysInMonth
@@ -384,7 +430,7 @@ func FromDayOfYear(dayOfYear DayOfYear, year Year) (Date, error) {
 	//   isMonth(month)
 	//   isDay(Day(remainingDays))
 	//   daysInPastMonths(month) + remainingDays == dayOfYear
-	date, _ := New(month, Day(remainingDays), year)
+	date, _ := mode.New(month, Day(remainingDays), year)
 	//
 	// Postcondition is true:
 	//   dayYear(date) = daysInPastMonth(month) + remainingDays = dayOfYear
@@ -493,7 +539,7 @@ func (date Date) Increment() (Date, error) {
 		return date, err
 	}
 
-	daysInMonth, err = DaysInMonth(date.month, date.year)
+	daysInMonth, err = PROLEPTIC.DaysInMonth(date.month, date.year)
 	if err != nil {
 		return date, err
 	}
@@ -526,12 +572,15 @@ func (date Date) Decrement() (Date, error) {
 	case date.day == 1 && date.month == 1:
 		result, err = New(12, 31, date.year-1)
 	case date.day == 1:
-		lastDay, err = DaysInMonth(date.month-1, date.year)
+		lastDay, err = PROLEPTIC.DaysInMonth(date.month-1, date.year)
 		assert.Assert(err == nil, "Unexpected error from DaysInMonth")
 		result, err = New(date.month-1, Day(lastDay), date.year)
 	default:
 		result, err = New(date.month, date.day-1, date.year)
 	}
+	if err != nil {
+		return date, err
+	}
 
 	var postCondition = func() error {
 		var date1, err = result.Increment()
@@ -555,6 +604,13 @@ func (date Date) Decrement() (Date, error) {
 // Add adds the number of days to the date if num > 0. Add subtracts the
 // number of days from the date if num < 0.
 func Add(date Date, num int) (Date, error) {
+	return STANDARD.Add(date, num)
+}
+
+// Add adds the number of days to the date if num > 0. Add subtracts the
+// number of days from the date if num < 0.  An error is returned if the
+// result is outside the range of the mode.
+func (mode Mode) Add(date Date, num int) (Date, error) {
 	var err error = nil
 	var absoluteDate AbsoluteDate
 	var resultDate Date
@@ -566,14 +622,14 @@ func Add(date Date, num int) (Date, error) {
 		return date, err
 	}
 	var result = int(absoluteDate) + num
-	if result < 1 {
+	if result < mode.MinDayNumber() {
 		var message = "value " +
 			strconv.Itoa(num) +
 			" is too negative to subtract from date " + date.String()
 		var err = errors.New(message)
 		return date, err
 	}
-	if result > int(MaxAbsoluteDate) {
+	if result > mode.MaxDayNumber() {
 		var message = "value " +
 			strconv.Itoa(num) +
 			" is too large to add to date " + date.String()
@@ -602,7 +658,7 @@ func Difference(date1 Date, date2 Date) int {
 	var diff = int(absoluteDate1 - absoluteDate2)
 
 	var postcondition = func() error {
-		var date, err = Add(date2, diff)
+		var date, err = PROLEPTIC.Add(date2, diff)
 		switch {
 		case err != nil:
 			break
@@ -697,7 +753,7 @@ func (date Date) String() string {
 		day = strconv.Itoa(int(date.day))
 	}
 	month = MonthName(date.month)
-	year = strconv.Itoa(int(date.year))
+	year = string(appendInt(nil, int(date.year), 4))
 	dateAsString = day + "-" + month + "-" + year
 	return dateAsString
 }
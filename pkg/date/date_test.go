@@ -12,10 +12,14 @@
 package date
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 // ----------------------------------------------------------------------------
@@ -459,6 +463,255 @@ func Test_WeekDay(t *testing.T) {
 	}
 }
 
+// Test_IsDayOfWeek tests the validation of days of the week.  Before the
+// check was corrected, isDayOfWeek rejected every valid day of the week and
+// accepted every invalid one.
+func Test_IsDayOfWeek(t *testing.T) {
+	for dayOfWeek := SUNDAY; dayOfWeek <= SATURDAY; dayOfWeek++ {
+		var err = isDayOfWeek(dayOfWeek)
+		if err != nil {
+			t.Errorf("isDayOfWeek rejected a valid day of the week: %s", err)
+		}
+	}
+	for _, dayOfWeek := range []DayOfWeek{-1, 7} {
+		if isDayOfWeek(dayOfWeek) == nil {
+			t.Errorf("isDayOfWeek accepted an invalid day of the week: %d", dayOfWeek)
+		}
+	}
+	if WeekDayName(SATURDAY) != "Saturday" {
+		t.Errorf("Wrong name of Saturday: %s", WeekDayName(SATURDAY))
+	}
+}
+
+// Test_WeekDaySearch tests the searches for the closest date with a day of
+// the week.
+func Test_WeekDaySearch(t *testing.T) {
+	type aTest struct {
+		name      string
+		search    func(date Date, dayOfWeek DayOfWeek) (Date, error)
+		dayOfWeek DayOfWeek
+		expected  Date
+	}
+
+	var wednesday, err = New(3, 13, 2024)
+	handle(err, t)
+
+	var data = []aTest{
+		{"after same day", Date.DayOfWeekAfter, WEDNESDAY, Date{3, 20, 2024}},
+		{"after Tuesday", Date.DayOfWeekAfter, TUESDAY, Date{3, 19, 2024}},
+		{"on or after same day", Date.DayOfWeekOnOrAfter, WEDNESDAY, Date{3, 13, 2024}},
+		{"on or after Monday", Date.DayOfWeekOnOrAfter, MONDAY, Date{3, 18, 2024}},
+		{"before same day", Date.DayOfWeekBefore, WEDNESDAY, Date{3, 6, 2024}},
+		{"before Friday", Date.DayOfWeekBefore, FRIDAY, Date{3, 8, 2024}},
+		{"on or before same day", Date.DayOfWeekOnOrBefore, WEDNESDAY, Date{3, 13, 2024}},
+		{"on or before Monday", Date.DayOfWeekOnOrBefore, MONDAY, Date{3, 11, 2024}},
+		{"nearest same day", Date.NearestDayOfWeek, WEDNESDAY, Date{3, 13, 2024}},
+		{"nearest Friday", Date.NearestDayOfWeek, FRIDAY, Date{3, 15, 2024}},
+		{"nearest Saturday", Date.NearestDayOfWeek, SATURDAY, Date{3, 16, 2024}},
+		{"nearest Sunday", Date.NearestDayOfWeek, SUNDAY, Date{3, 10, 2024}},
+		{"nearest Monday", Date.NearestDayOfWeek, MONDAY, Date{3, 11, 2024}},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var result, err = tt.search(wednesday, tt.dayOfWeek)
+		handle(err, t)
+		if result != tt.expected {
+			t.Fatalf("Search from %s for day of week %d returned %s, not %s",
+				wednesday, tt.dayOfWeek, result, tt.expected)
+		}
+	}
+
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var maxWeekDay, _ = MaxDate.WeekDay()
+	_, err = MaxDate.DayOfWeekAfter(maxWeekDay)
+	if err == nil {
+		t.Error("DayOfWeekAfter did not detect a date after the maximum date")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = MinDate.DayOfWeekBefore(maxWeekDay)
+	if err == nil {
+		t.Error("DayOfWeekBefore did not detect a date before the minimum date")
+	} else {
+		fmt.Println(err)
+	}
+}
+
+// Test_NthWeekDayOfMonth tests the calculation of the nth day of the week in
+// a month.
+func Test_NthWeekDayOfMonth(t *testing.T) {
+	type aTest struct {
+		name      string
+		n         int
+		dayOfWeek DayOfWeek
+		month     Month
+		year      Year
+		expected  Date
+		valid     bool
+	}
+
+	var data = []aTest{
+		{"third Wednesday", 3, WEDNESDAY, 3, 2024, Date{3, 20, 2024}, true},
+		{"first Friday", 1, FRIDAY, 3, 2024, Date{3, 1, 2024}, true},
+		{"fifth Friday", 5, FRIDAY, 3, 2024, Date{3, 29, 2024}, true},
+		{"last Friday", -1, FRIDAY, 3, 2024, Date{3, 29, 2024}, true},
+		{"second-to-last Friday", -2, FRIDAY, 3, 2024, Date{3, 22, 2024}, true},
+		{"fifth-to-last Friday", -5, FRIDAY, 3, 2024, Date{3, 1, 2024}, true},
+		{"last Thursday leap day", -1, THURSDAY, 2, 2024, Date{2, 29, 2024}, true},
+		{"last Saturday", -1, SATURDAY, 12, MaxYear, Date{12, 25, MaxYear}, true},
+		{"fifth Wednesday", 5, WEDNESDAY, 3, 2024, Date{}, false},
+		{"fifth-to-last Wednesday", -5, WEDNESDAY, 3, 2024, Date{}, false},
+		{"sixth Friday", 6, FRIDAY, 3, 2024, Date{}, false},
+		{"zero", 0, FRIDAY, 3, 2024, Date{}, false},
+		{"invalid month", 1, FRIDAY, 13, 2024, Date{}, false},
+		{"invalid year", 1, FRIDAY, 1, MaxYear + 1, Date{}, false},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var result, err = NthWeekDayOfMonth(tt.n, tt.dayOfWeek, tt.month, tt.year)
+		if !tt.valid {
+			if err == nil {
+				t.Fatalf("NthWeekDayOfMonth did not detect an invalid occurrence: %s", result)
+			}
+			fmt.Println(err)
+			return
+		}
+		handle(err, t)
+		if result != tt.expected {
+			t.Fatalf("NthWeekDayOfMonth returned %s, not %s", result, tt.expected)
+		}
+	}
+
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_Boundaries tests the first and last dates of the week, month,
+// quarter, and year containing a date.
+func Test_Boundaries(t *testing.T) {
+	type aTest struct {
+		name     string
+		date     Date
+		boundary func(date Date) Date
+		expected Date
+	}
+
+	var wednesday = Date{2, 14, 2024}
+	var november = Date{11, 30, 2023}
+
+	var data = []aTest{
+		{"start of month", wednesday, Date.StartOfMonth, Date{2, 1, 2024}},
+		{"end of month leap year", wednesday, Date.EndOfMonth, Date{2, 29, 2024}},
+		{"end of month last day", november, Date.EndOfMonth, Date{11, 30, 2023}},
+		{"start of quarter 1", wednesday, Date.StartOfQuarter, Date{1, 1, 2024}},
+		{"end of quarter 1", wednesday, Date.EndOfQuarter, Date{3, 31, 2024}},
+		{"start of quarter 4", november, Date.StartOfQuarter, Date{10, 1, 2023}},
+		{"end of quarter 4", november, Date.EndOfQuarter, Date{12, 31, 2023}},
+		{"start of year", wednesday, Date.StartOfYear, Date{1, 1, 2024}},
+		{"end of year", wednesday, Date.EndOfYear, Date{12, 31, 2024}},
+		{"start of minimum year", MinDate, Date.StartOfYear, MinDate},
+		{"end of maximum month", MaxDate, Date.EndOfMonth, MaxDate},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var result = tt.boundary(tt.date)
+		if result != tt.expected {
+			t.Fatalf("Boundary of %s is %s, not %s", tt.date, result, tt.expected)
+		}
+	}
+
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_WeekBoundaries tests the first and last dates of the week containing
+// a date for weeks beginning on different days.
+func Test_WeekBoundaries(t *testing.T) {
+	type aTest struct {
+		name           string
+		firstDayOfWeek DayOfWeek
+		start          Date
+		end            Date
+	}
+
+	var wednesday = Date{2, 14, 2024}
+
+	var data = []aTest{
+		{"Sunday", SUNDAY, Date{2, 11, 2024}, Date{2, 17, 2024}},
+		{"Monday", MONDAY, Date{2, 12, 2024}, Date{2, 18, 2024}},
+		{"Wednesday", WEDNESDAY, Date{2, 14, 2024}, Date{2, 20, 2024}},
+		{"Thursday", THURSDAY, Date{2, 8, 2024}, Date{2, 14, 2024}},
+		{"Saturday", SATURDAY, Date{2, 10, 2024}, Date{2, 16, 2024}},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var start, err = wednesday.StartOfWeek(tt.firstDayOfWeek)
+		handle(err, t)
+		var end, err2 = wednesday.EndOfWeek(tt.firstDayOfWeek)
+		handle(err2, t)
+		if start != tt.start || end != tt.end {
+			t.Fatalf("Week of %s is %s through %s, not %s through %s",
+				wednesday, start, end, tt.start, tt.end)
+		}
+	}
+
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var minWeekDay, _ = MinDate.WeekDay()
+	var _, err = MinDate.StartOfWeek(DayOfWeek((minWeekDay + 1) % 7))
+	if err == nil {
+		t.Error("StartOfWeek did not detect a week beginning before the minimum date")
+	} else {
+		fmt.Println(err)
+	}
+	var maxWeekDay, _ = MaxDate.WeekDay()
+	_, err = MaxDate.EndOfWeek(maxWeekDay)
+	if err == nil {
+		t.Error("EndOfWeek did not detect a week ending after the maximum date")
+	} else {
+		fmt.Println(err)
+	}
+
+	// A proleptic date has proleptic boundaries
+	var ides, _ = PROLEPTIC.New(3, 15, -44)
+	var start, end Date
+	start, err = ides.StartOfWeek(MONDAY)
+	handle(err, t)
+	end, err = ides.EndOfWeek(MONDAY)
+	handle(err, t)
+	if start != (Date{3, 12, -44}) || end != (Date{3, 18, -44}) {
+		t.Errorf("Week of %s is %s through %s", ides, start, end)
+	}
+	if ides.StartOfMonth() != (Date{3, 1, -44}) || ides.EndOfMonth() != (Date{3, 31, -44}) ||
+		ides.EndOfQuarter() != (Date{3, 31, -44}) || ides.StartOfYear() != (Date{1, 1, -44}) {
+		t.Errorf("Wrong month, quarter, or year boundaries of %s", ides)
+	}
+	_, err = ProlepticMaxDate.EndOfWeek(SUNDAY)
+	if err == nil {
+		t.Error("EndOfWeek did not detect a week ending after the proleptic maximum date")
+	}
+	start, err = Date{1, 1, 4000}.StartOfWeek(MONDAY)
+	handle(err, t)
+	if start != (Date{12, 27, 3999}) {
+		t.Errorf("Week of 01-Jan-4000 starts on %s", start)
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Test YearMonth
 // ----------------------------------------------------------------------------
@@ -518,3 +771,1431 @@ func Test_Keys(t *testing.T) {
 	}
 	t.Run("Keys", testFunction)
 }
+
+// ----------------------------------------------------------------------------
+// Test encoding
+// ----------------------------------------------------------------------------
+
+// Test_MarshalJSON tests the encoding of dates as JSON strings.
+func Test_MarshalJSON(t *testing.T) {
+	type record struct {
+		Start Date `json:"start"`
+	}
+	var date, err = New(10, 16, 2024)
+	handle(err, t)
+
+	var testFunction = func(t *testing.T) {
+		var data, err = json.Marshal(record{Start: date})
+		handle(err, t)
+		if string(data) != `{"start":"2024-10-16"}` {
+			t.Fatalf("Wrong JSON encoding: %s", data)
+		}
+		data, err = json.Marshal(record{})
+		handle(err, t)
+		if string(data) != `{"start":null}` {
+			t.Fatalf("Wrong JSON encoding of zero date: %s", data)
+		}
+	}
+	t.Run("Marshal JSON", testFunction)
+}
+
+// Test_UnmarshalJSON tests the decoding of dates from JSON strings.
+func Test_UnmarshalJSON(t *testing.T) {
+	type aTest struct {
+		name  string
+		value string
+		error bool
+	}
+	var data = []aTest{
+		{"valid date", `"2024-02-29"`, false},
+		{"null", `null`, false},
+		{"not a leap year", `"2023-02-29"`, true},
+		{"invalid month", `"2024-13-01"`, true},
+		{"year out of range", `"1600-01-01"`, true},
+		{"wrong format", `"02/29/2024"`, true},
+		{"not a string", `20240229`, true},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date Date
+		var err = json.Unmarshal([]byte(tt.value), &date)
+		if tt.error && err == nil {
+			t.Fatalf("UnmarshalJSON should have reported error for %s", tt.value)
+		} else if !tt.error && err != nil {
+			t.Fatalf("UnmarshalJSON incorrectly reported an error for %s: %s", tt.value, err)
+		} else if err != nil {
+			fmt.Println(err)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var date, err = New(2, 29, 2024)
+	handle(err, t)
+	var decoded Date
+	err = json.Unmarshal([]byte(`"2024-02-29"`), &decoded)
+	handle(err, t)
+	if decoded != date {
+		t.Fatalf("Wrong decoded date: %s", decoded)
+	}
+}
+
+// Test_Scan tests the conversion of database values into dates.
+func Test_Scan(t *testing.T) {
+	type aTest struct {
+		name  string
+		value any
+		error bool
+	}
+	var expected, err = New(1, 15, 2024)
+	handle(err, t)
+	var data = []aTest{
+		{"time", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"string", "2024-01-15", false},
+		{"bytes", []byte("2024-01-15"), false},
+		{"timestamp string", "2024-01-15 00:00:00+00:00", false},
+		{"RFC 3339 string", "2024-01-15T00:00:00Z", false},
+		{"invalid string", "2024-01-32", true},
+		{"invalid type", 20240115, true},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date Date
+		var err = date.Scan(tt.value)
+		switch {
+		case tt.error && err == nil:
+			t.Fatalf("Scan should have reported error for %v", tt.value)
+		case !tt.error && err != nil:
+			t.Fatalf("Scan incorrectly reported an error for %v: %s", tt.value, err)
+		case !tt.error && date != expected:
+			t.Fatalf("Scan returned wrong date: %s", date)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var date Date
+	err = date.Scan("infinity")
+	handle(err, t)
+	if date != MaxDate {
+		t.Fatalf("Scan of infinity returned %s, not MaxDate", date)
+	}
+	err = date.Scan([]byte("-infinity"))
+	handle(err, t)
+	if date != MinDate {
+		t.Fatalf("Scan of -infinity returned %s, not MinDate", date)
+	}
+}
+
+// Test_Value tests the conversion of dates into database values.
+func Test_Value(t *testing.T) {
+	var date, err = New(1, 15, 2024)
+	handle(err, t)
+
+	var testFunction = func(t *testing.T) {
+		var value, err = date.Value()
+		handle(err, t)
+		if value != "2024-01-15" {
+			t.Fatalf("Wrong database value: %v", value)
+		}
+		value, err = Date{}.Value()
+		handle(err, t)
+		if value != nil {
+			t.Fatalf("Zero date should be NULL, not %v", value)
+		}
+	}
+	t.Run("Value", testFunction)
+}
+
+// ----------------------------------------------------------------------------
+// Test formatting
+// ----------------------------------------------------------------------------
+
+// Test_Format tests the formatting of dates with layouts.
+func Test_Format(t *testing.T) {
+	type aTest struct {
+		name     string
+		layout   string
+		expected string
+	}
+	var date, err = New(10, 16, 2024)
+	handle(err, t)
+	var data = []aTest{
+		{"ISO 8601", ISO8601, "2024-10-16"},
+		{"ISO 8601 basic", ISO8601Basic, "20241016"},
+		{"US slashes", USSlash, "10/16/2024"},
+		{"DateBench", DateBench, date.String()},
+		{"full month name", "d MMMM yyyy", "16 October 2024"},
+		{"weekday abbreviation", "EEE d MMM", "Wed 16 Oct"},
+		{"full weekday name", "EEEE, MMMM d, yyyy", "Wednesday, October 16, 2024"},
+		{"two digit year", "M/d/yy", "10/16/24"},
+		{"day of year", "yyyy-DDD", "2024-290"},
+		{"quoted text", "'Day' D 'of' yyyy, 'o''clock'", "Day 290 of 2024, o'clock"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var actual = date.Format(tt.layout)
+		if actual != tt.expected {
+			t.Fatalf("Layout %s produced %s instead of %s", tt.layout, actual, tt.expected)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_AppendFormat tests that AppendFormat does not allocate memory when the
+// buffer is large enough.
+func Test_AppendFormat(t *testing.T) {
+	var date, err = New(1, 5, 2024)
+	handle(err, t)
+	var buffer = make([]byte, 0, 64)
+
+	var allocations = testing.AllocsPerRun(100, func() {
+		buffer = date.AppendFormat(buffer[:0], "EEEE dd MMMM yyyy DDD")
+	})
+	if allocations != 0 {
+		t.Fatalf("AppendFormat allocated memory %f times", allocations)
+	}
+	if string(buffer) != "Friday 05 January 2024 005" {
+		t.Fatalf("Wrong formatted date: %s", buffer)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test parsing
+// ----------------------------------------------------------------------------
+
+// Test_Parse tests the parsing of dates with layouts.
+func Test_Parse(t *testing.T) {
+	type aTest struct {
+		name   string
+		layout string
+		value  string
+		field  string
+	}
+	var expected, err = New(10, 16, 2024)
+	handle(err, t)
+	var data = []aTest{
+		{"ISO 8601", ISO8601, "2024-10-16", ""},
+		{"ISO 8601 basic", ISO8601Basic, "20241016", ""},
+		{"DateBench", DateBench, "16-Oct-2024", ""},
+		{"DateBench upper case", DateBench, "16-OCT-2024", ""},
+		{"European dots", EUDot, "16.10.2024", ""},
+		{"US slashes", USSlash, "10/16/2024", ""},
+		{"variable digits", "M/d/y", "10/16/2024", ""},
+		{"full names", "EEEE, d MMMM yyyy", "Wednesday, 16 October 2024", ""},
+		{"two digit year", "dd.MM.yy", "16.10.24", ""},
+		{"day of year", "yyyy-DDD", "2024-290", ""},
+		{"bad month", ISO8601, "2024-13-16", FieldMonth},
+		{"bad day", ISO8601, "2023-02-29", FieldDay},
+		{"expanded year", ISO8601, "+2024-10-16", ""},
+		{"bad year", ISO8601, "1600-10-16", FieldYear},
+		{"negative year", ISO8601, "-0044-03-15", FieldYear},
+		{"invalid year", ISO8601, "20x4-10-16", FieldYear},
+		{"missing digits", ISO8601, "2024-1-16", FieldMonth},
+		{"bad separator", ISO8601, "2024/10/16", FieldLiteral},
+		{"trailing text", ISO8601, "2024-10-16x", FieldLiteral},
+		{"bad month name", DateBench, "16-Okt-2024", FieldMonth},
+		{"wrong weekday", "EEE d MMM yyyy", "Thu 16 Oct 2024", FieldWeekDay},
+		{"bad day of year", "yyyy-DDD", "2023-366", FieldDayOfYear},
+		{"no day", "yyyy-MM", "2024-10", FieldDay},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date, err = Parse(tt.layout, tt.value)
+		if tt.field == "" {
+			handle(err, t)
+			if date != expected {
+				t.Fatalf("Parse returned %s instead of %s", date, expected)
+			}
+			return
+		}
+		var parseError *ParseError
+		if !errors.As(err, &parseError) {
+			t.Fatalf("Parse did not return a ParseError for %s", tt.value)
+		}
+		if parseError.Field != tt.field {
+			t.Fatalf("Parse reported field %s instead of %s: %s", parseError.Field, tt.field, err)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_ParseAny tests the parsing of dates with a list of layouts.
+func Test_ParseAny(t *testing.T) {
+	var expected, err = New(10, 16, 2024)
+	handle(err, t)
+	var values = []string{"2024-10-16", "20241016", "16-Oct-2024", "16.10.2024", "10/16/2024", " 2024-10-16 "}
+
+	for _, value := range values {
+		var date, err = ParseAny(value)
+		handle(err, t)
+		if date != expected {
+			t.Errorf("ParseAny returned %s for %s", date, value)
+		}
+	}
+
+	var april10, _ = New(4, 10, 2024)
+	var date Date
+	date, err = ParseAny("04/10/2024", USSlash, "dd/MM/yyyy")
+	handle(err, t)
+	if date != april10 {
+		t.Errorf("ParseAny returned %s, not the date from the first layout", date)
+	}
+	date, err = ParseAny("04.10.2024")
+	handle(err, t)
+	if date.Month() != 10 || date.Day() != 4 {
+		t.Errorf("ParseAny returned %s for 04.10.2024", date)
+	}
+
+	_, err = ParseAny("16/10/2024", USSlash)
+	var parseError *ParseError
+	if !errors.As(err, &parseError) || parseError.Field != FieldMonth {
+		t.Errorf("ParseAny did not report invalid month: %v", err)
+	}
+	_, err = ParseAny("October 16, 2024")
+	if err == nil {
+		t.Errorf("ParseAny did not report an error")
+	} else {
+		fmt.Println(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test ISO week
+// ----------------------------------------------------------------------------
+
+// Test_ISOWeek tests the conversion of dates to ISO weeks, including dates
+// whose week-year differs from the calendar year.
+func Test_ISOWeek(t *testing.T) {
+	type aTest struct {
+		name   string
+		date   string
+		week   string
+		isoDay int
+	}
+	var data = []aTest{
+		{"mid year", "2024-10-16", "2024-W42", 3},
+		{"week-year after calendar year", "2024-12-30", "2025-W01", 1},
+		{"week-year before calendar year", "2021-01-01", "2020-W53", 5},
+		{"Sunday ends week", "2021-01-03", "2020-W53", 7},
+		{"first Monday", "2021-01-04", "2021-W01", 1},
+		{"MinDate", "1601-01-01", "1601-W01", 1},
+		{"MaxDate", "3999-12-31", "3999-W52", 5},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date, err = Parse(ISO8601, tt.date)
+		handle(err, t)
+		var isoWeek, isoDay, err2 = date.ISOWeek()
+		handle(err2, t)
+		if isoWeek.String() != tt.week || isoDay != tt.isoDay {
+			t.Fatalf("Date %s is in %s-%d, not %s-%d", tt.date, isoWeek, isoDay, tt.week, tt.isoDay)
+		}
+		var converted, err3 = isoWeek.Date(isoDay)
+		handle(err3, t)
+		if converted != date {
+			t.Fatalf("ISO week %s-%d converted to %s, not %s", isoWeek, isoDay, converted, date)
+		}
+		var weekDate, err4 = date.ISOWeekString()
+		handle(err4, t)
+		var parsed, err5 = ParseISOWeekDate(weekDate)
+		handle(err5, t)
+		if parsed != date {
+			t.Fatalf("ISO week date %s parsed as %s", weekDate, parsed)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_WeeksInISOYear tests the identification of 53-week years.
+func Test_WeeksInISOYear(t *testing.T) {
+	var longYears = []Year{2004, 2009, 2015, 2020, 2026}
+	for year := Year(2000); year <= 2030; year++ {
+		var expected = 52
+		for _, longYear := range longYears {
+			if year == longYear {
+				expected = 53
+			}
+		}
+		if WeeksInISOYear(year) != expected {
+			t.Errorf("Year %d should have %d weeks", year, expected)
+		}
+	}
+}
+
+// Test_ParseISOWeek tests the parsing of ISO weeks and week dates.
+func Test_ParseISOWeek(t *testing.T) {
+	type aTest struct {
+		name  string
+		value string
+		error bool
+	}
+	var data = []aTest{
+		{"extended", "2024-W42", false},
+		{"basic", "2024W42", false},
+		{"week 53", "2020-W53", false},
+		{"no week 53", "2024-W53", true},
+		{"week 0", "2024-W00", true},
+		{"missing W", "2024-42", true},
+		{"trailing text", "2024-W42-3", true},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var _, err = ParseISOWeek(tt.value)
+		if tt.error && err == nil {
+			t.Fatalf("ParseISOWeek should have reported error for %s", tt.value)
+		} else if !tt.error && err != nil {
+			t.Fatalf("ParseISOWeek incorrectly reported an error for %s: %s", tt.value, err)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var expected, err = New(10, 16, 2024)
+	handle(err, t)
+	for _, value := range []string{"2024-W42-3", "2024W423"} {
+		var date, err = ParseISOWeekDate(value)
+		handle(err, t)
+		if date != expected {
+			t.Errorf("ParseISOWeekDate returned %s for %s", date, value)
+		}
+	}
+	for _, value := range []string{"2024-W42-8", "2024-W423", "2024W42-3"} {
+		var _, err = ParseISOWeekDate(value)
+		if err == nil {
+			t.Errorf("ParseISOWeekDate should have reported error for %s", value)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test weekend
+// ----------------------------------------------------------------------------
+
+// Test_Weekend tests weekend definitions.
+func Test_Weekend(t *testing.T) {
+	var friday, err = New(7, 5, 2024)
+	handle(err, t)
+	var sunday, _ = New(7, 7, 2024)
+
+	if SaturdaySunday.IsWeekend(friday) || !SaturdaySunday.IsWeekend(sunday) {
+		t.Error("Wrong Saturday and Sunday weekend")
+	}
+	if !FridaySaturday.IsWeekend(friday) || FridaySaturday.IsWeekend(sunday) {
+		t.Error("Wrong Friday and Saturday weekend")
+	}
+	if SundayOnly.WorkDaysPerWeek() != 6 || !SundayOnly.Contains(SUNDAY) {
+		t.Error("Wrong Sunday only weekend")
+	}
+	if SaturdaySunday.String() != "Sunday,Saturday" {
+		t.Errorf("Wrong weekend string: %s", SaturdaySunday)
+	}
+	var weekend Weekend
+	weekend, err = NewWeekend(SATURDAY, SATURDAY)
+	handle(err, t)
+	if weekend.WorkDaysPerWeek() != 6 {
+		t.Error("Repeated days should be ignored")
+	}
+	_, err = NewWeekend(weekDays...)
+	if err == nil {
+		t.Error("NewWeekend did not detect a weekend without work days")
+	}
+	_, err = NewWeekend(DayOfWeek(7))
+	if err == nil {
+		t.Error("NewWeekend did not detect an invalid day of the week")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test month arithmetic
+// ----------------------------------------------------------------------------
+
+// Test_AddMonths tests the addition of months and years under each policy.
+func Test_AddMonths(t *testing.T) {
+	type aTest struct {
+		name     string
+		date     string
+		num      int
+		years    bool
+		policy   MonthPolicy
+		expected string
+	}
+	var clamp = MonthPolicy{}
+	var overflow = MonthPolicy{InvalidDay: OVERFLOW}
+	var reject = MonthPolicy{InvalidDay: REJECT}
+	var sticky = MonthPolicy{StickyEndOfMonth: true}
+	var data = []aTest{
+		{"ordinary", "2024-01-15", 1, false, clamp, "2024-02-15"},
+		{"clamp leap year", "2024-01-31", 1, false, clamp, "2024-02-29"},
+		{"clamp", "2023-01-31", 1, false, clamp, "2023-02-28"},
+		{"overflow", "2024-01-31", 1, false, overflow, "2024-03-02"},
+		{"reject", "2024-01-31", 1, false, reject, ""},
+		{"reject valid", "2024-01-30", 2, false, reject, "2024-03-30"},
+		{"sticky", "2023-02-28", 1, false, sticky, "2023-03-31"},
+		{"sticky from 30th", "2024-04-30", 1, false, sticky, "2024-05-31"},
+		{"not sticky", "2023-02-28", 1, false, clamp, "2023-03-28"},
+		{"sticky not month end", "2024-03-30", 1, false, sticky, "2024-04-30"},
+		{"over year end", "2024-11-30", 3, false, clamp, "2025-02-28"},
+		{"subtract", "2024-03-31", -1, false, clamp, "2024-02-29"},
+		{"subtract over year", "2024-01-15", -13, false, clamp, "2022-12-15"},
+		{"zero", "2024-01-31", 0, false, reject, "2024-01-31"},
+		{"add year leap day", "2024-02-29", 1, true, clamp, "2025-02-28"},
+		{"add year leap day overflow", "2024-02-29", 1, true, overflow, "2025-03-01"},
+		{"add year sticky", "2023-02-28", 1, true, sticky, "2024-02-29"},
+		{"subtract years", "2024-10-16", -10, true, clamp, "2014-10-16"},
+		{"beyond MaxYear", "3999-06-15", 7, false, clamp, ""},
+		{"before MinYear", "1601-06-15", -6, false, clamp, ""},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date, err = Parse(ISO8601, tt.date)
+		handle(err, t)
+		var result Date
+		if tt.years {
+			result, err = AddYears(date, tt.num, tt.policy)
+		} else {
+			result, err = AddMonths(date, tt.num, tt.policy)
+		}
+		if tt.expected == "" {
+			if err == nil {
+				t.Fatalf("Expected an error, not %s", result)
+			}
+			return
+		}
+		handle(err, t)
+		if result.Format(ISO8601) != tt.expected {
+			t.Fatalf("Expected %s, not %s", tt.expected, result.Format(ISO8601))
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test period
+// ----------------------------------------------------------------------------
+
+// Test_Between tests the computation of periods between dates.
+func Test_Between(t *testing.T) {
+	type aTest struct {
+		name     string
+		date1    string
+		date2    string
+		expected string
+	}
+	var data = []aTest{
+		{"years months days", "2021-07-04", "2023-10-16", "P2Y3M12D"},
+		{"same date", "2024-10-16", "2024-10-16", "P0D"},
+		{"month end", "2024-01-31", "2024-03-01", "P1M1D"},
+		{"clamped month", "2024-01-31", "2024-02-29", "P1M"},
+		{"days only", "2024-02-10", "2024-03-05", "P24D"},
+		{"negative", "2023-10-16", "2021-07-04", "P-2Y-3M-12D"},
+		{"negative month end", "2024-03-31", "2024-02-29", "P-1M"},
+		{"whole years", "2020-02-29", "2024-02-29", "P4Y"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date1, err1 = Parse(ISO8601, tt.date1)
+		handle(err1, t)
+		var date2, err2 = Parse(ISO8601, tt.date2)
+		handle(err2, t)
+		var period = Between(date1, date2)
+		if period.String() != tt.expected {
+			t.Fatalf("Expected %s, not %s", tt.expected, period)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_BetweenRoundTrip tests that adding the period between two dates to
+// the first date yields the second date.
+func Test_BetweenRoundTrip(t *testing.T) {
+	var start, err = New(11, 25, 2023)
+	handle(err, t)
+	for i := 0; i < 100; i++ {
+		var date1, _ = Add(start, i)
+		for j := -400; j <= 400; j += 7 {
+			var date2, _ = Add(date1, j)
+			var period = Between(date1, date2)
+			var result, err = AddPeriod(date1, period)
+			handle(err, t)
+			if result != date2 {
+				t.Fatalf("%s + %s = %s, not %s", date1, period, result, date2)
+			}
+		}
+	}
+}
+
+// Test_ParsePeriod tests the parsing of ISO 8601 durations.
+func Test_ParsePeriod(t *testing.T) {
+	type aTest struct {
+		name     string
+		value    string
+		expected string
+	}
+	var data = []aTest{
+		{"full", "P2Y3M12D", "P2Y3M12D"},
+		{"weeks", "P2W", "P14D"},
+		{"weeks and days", "P1W2D", "P9D"},
+		{"negated", "-P1Y2M", "P-1Y-2M"},
+		{"negative component", "P-1M", "P-1M"},
+		{"zero", "P0D", "P0D"},
+		{"lower case", "p1y", "P1Y"},
+		{"time", "P1DT2H", ""},
+		{"out of order", "P1D2M", ""},
+		{"no components", "P", ""},
+		{"no unit", "P12", ""},
+		{"no P", "2Y", ""},
+		{"unknown unit", "P1X", ""},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var period, err = ParsePeriod(tt.value)
+		if tt.expected == "" {
+			if err == nil {
+				t.Fatalf("ParsePeriod should have reported error for %s", tt.value)
+			}
+			return
+		}
+		handle(err, t)
+		if period.String() != tt.expected {
+			t.Fatalf("Expected %s, not %s", tt.expected, period)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var period = NewPeriod(1, 14, -3)
+	if period.Normalized() != NewPeriod(2, 2, -3) {
+		t.Errorf("Wrong normalized period: %s", period.Normalized())
+	}
+	if period.Negated() != NewPeriod(-1, -14, 3) {
+		t.Errorf("Wrong negated period: %s", period.Negated())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test Quarter
+// ----------------------------------------------------------------------------
+
+// Test_Quarter tests the construction and boundaries of quarters.
+func Test_Quarter(t *testing.T) {
+	var date, err = New(8, 15, 2024)
+	handle(err, t)
+	var quarter, err2 = NewQuarterFromDate(date)
+	handle(err2, t)
+	if quarter.String() != "2024-Q3" {
+		t.Fatalf("Date %s is in %s", date, quarter)
+	}
+	if quarter.First().Format(ISO8601) != "2024-07-01" || quarter.Last().Format(ISO8601) != "2024-09-30" {
+		t.Fatalf("Wrong boundaries for %s: %s %s", quarter, quarter.First(), quarter.Last())
+	}
+	var afterLast, _ = quarter.Last().Increment()
+	if !quarter.Contains(date) || quarter.Contains(afterLast) {
+		t.Fatalf("Wrong containment for %s", quarter)
+	}
+
+	var yearMonth, _ = NewYearMonth(2024, 12)
+	quarter, err = NewQuarterFromYearMonth(yearMonth)
+	handle(err, t)
+	var next, err3 = quarter.Next()
+	handle(err3, t)
+	if next.String() != "2025-Q1" {
+		t.Fatalf("Quarter after %s is %s", quarter, next)
+	}
+	var prev, err4 = next.Prev()
+	handle(err4, t)
+	if prev != quarter {
+		t.Fatalf("Quarter before %s is %s", next, prev)
+	}
+	var lastQuarter, _ = NewQuarterFromDate(MaxDate)
+	_, err = lastQuarter.Next()
+	if err == nil {
+		t.Fatalf("Next did not detect the end of the range of dates")
+	}
+}
+
+// Test_ParseQuarter tests the parsing of quarters.
+func Test_ParseQuarter(t *testing.T) {
+	type aTest struct {
+		name  string
+		value string
+		error bool
+	}
+	var data = []aTest{
+		{"valid", "2024-Q3", false},
+		{"lower case", "2024-q1", false},
+		{"quarter 5", "2024-Q5", true},
+		{"quarter 0", "2024-Q0", true},
+		{"missing dash", "2024Q3", true},
+		{"trailing text", "2024-Q31", true},
+		{"year out of range", "1600-Q1", true},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var quarter, err = ParseQuarter(tt.value)
+		switch {
+		case tt.error && err == nil:
+			t.Fatalf("ParseQuarter should have reported error for %s", tt.value)
+		case !tt.error && err != nil:
+			t.Fatalf("ParseQuarter incorrectly reported an error for %s: %s", tt.value, err)
+		case !tt.error && !strings.EqualFold(quarter.String(), tt.value):
+			t.Fatalf("ParseQuarter returned %s for %s", quarter, tt.value)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_QuarterKeys tests the generation of a slice of quarters in a range.
+func Test_QuarterKeys(t *testing.T) {
+	var from, _ = NewQuarter(2022, 3)
+	var thru, _ = NewQuarter(2025, 2)
+	var keys, err = QuarterKeys(from, thru)
+	handle(err, t)
+	if len(keys) != 12 || keys[0] != from || keys[len(keys)-1] != thru {
+		t.Fatalf("Wrong quarter keys: %v", keys)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test day numbers
+// ----------------------------------------------------------------------------
+
+// Test_DayNumber tests the conversion between dates and day numbers.
+func Test_DayNumber(t *testing.T) {
+	var dec19, err = New(12, 19, 2023)
+	handle(err, t)
+	if MinDate.DayNumber() != 1 || MinDayNumber != 1 {
+		t.Fatalf("Day number of 1-Jan-1601 must be 1, not %d", MinDate.DayNumber())
+	}
+	if MaxDate.DayNumber() != MaxDayNumber {
+		t.Fatalf("Day number of MaxDate must be MaxDayNumber, not %d", MaxDate.DayNumber())
+	}
+	if dec19.DayNumber() != 154485 {
+		t.Fatalf("Wrong day number for %s: %d", dec19, dec19.DayNumber())
+	}
+	var date Date
+	date, err = FromDayNumber(154485)
+	handle(err, t)
+	if date != dec19 {
+		t.Fatalf("Day number 154485 is %s, not %s", date, dec19)
+	}
+	var next, _ = dec19.Increment()
+	if next.DayNumber()-dec19.DayNumber() != 1 {
+		t.Fatalf("Consecutive dates do not have consecutive day numbers")
+	}
+	_, err = FromDayNumber(MinDayNumber - 1)
+	if err == nil {
+		t.Fatalf("FromDayNumber did not detect a day number below the range")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = FromDayNumber(MaxDayNumber + 1)
+	if err == nil {
+		t.Fatalf("FromDayNumber did not detect a day number above the range")
+	}
+}
+
+// Test_ProlepticRange tests dates created in the PROLEPTIC mode, including
+// year zero and negative years, and checks that the STANDARD mode keeps the
+// range 1601 through 3999.
+func Test_ProlepticRange(t *testing.T) {
+	var ides, err = PROLEPTIC.Parse(ISO8601, "-0044-03-15")
+	handle(err, t)
+	if ides.Year() != -44 || ides.String() != "15-Mar--0044" {
+		t.Fatalf("Wrong date for -0044-03-15: %s", ides)
+	}
+	var weekDay, _ = ides.WeekDay()
+	if weekDay != THURSDAY {
+		t.Fatalf("Date %s is on day %d, not Thursday", ides, weekDay)
+	}
+	var yearZero, _ = PROLEPTIC.New(1, 1, 0)
+	if yearZero.DayNumber() != -584753 {
+		t.Fatalf("Wrong day number for %s: %d", yearZero, yearZero.DayNumber())
+	}
+	weekDay, _ = yearZero.WeekDay()
+	if weekDay != SATURDAY {
+		t.Fatalf("Date %s is on day %d, not Saturday", yearZero, weekDay)
+	}
+	var days, _ = PROLEPTIC.DaysInYear(0)
+	if days != 366 {
+		t.Fatalf("Year 0 has %d days, not 366", days)
+	}
+	days, _ = PROLEPTIC.DaysInMonth(2, -100)
+	if days != 28 {
+		t.Fatalf("February -100 has %d days, not 28", days)
+	}
+	var jan1, _ = PROLEPTIC.New(1, 1, 1)
+	var dec31, _ = PROLEPTIC.New(12, 31, 0)
+	if Difference(jan1, dec31) != 1 {
+		t.Fatalf("Difference across year zero is %d", Difference(jan1, dec31))
+	}
+	var date Date
+	date, err = PROLEPTIC.Add(jan1, -1)
+	handle(err, t)
+	if date != dec31 {
+		t.Fatalf("Day before %s is %s", jan1, date)
+	}
+	date, err = PROLEPTIC.FromDayNumber(ProlepticMinDayNumber)
+	handle(err, t)
+	if date != ProlepticMinDate || ProlepticMaxDate.DayNumber() != ProlepticMaxDayNumber {
+		t.Fatalf("Day number %d is %s, not %s", ProlepticMinDayNumber, date, ProlepticMinDate)
+	}
+	if !PROLEPTIC.Contains(ides) || STANDARD.Contains(ides) || IsADate(ides) != nil {
+		t.Fatalf("Date %s is in the wrong mode", ides)
+	}
+	//
+	// The standard range is unchanged
+	//
+	var errs = []error{}
+	_, err = New(1, 1, 1600)
+	errs = append(errs, err)
+	_, err = NewFromString("12/31/4000")
+	errs = append(errs, err)
+	_, err = Parse(ISO8601, "-0044-03-15")
+	errs = append(errs, err)
+	_, err = FromDayNumber(0)
+	errs = append(errs, err)
+	_, err = Add(MinDate, -1)
+	errs = append(errs, err)
+	_, err = jan1.Decrement()
+	errs = append(errs, err)
+	_, err = PROLEPTIC.Add(ProlepticMaxDate, 1)
+	errs = append(errs, err)
+	for index, err := range errs {
+		if err == nil {
+			t.Fatalf("Case %d did not detect a date outside the range of its mode", index)
+		}
+		fmt.Println(err)
+	}
+
+	//
+	// Functions that accept any valid date do not panic on a proleptic date
+	//
+	if !IsLeapYear(-44) || IsLeapYear(-100) || !IsLeapYear(0) {
+		t.Fatalf("Leap years before 1601 are not identified correctly")
+	}
+	var weekDate string
+	weekDate, err = ides.ISOWeekString()
+	if err == nil {
+		t.Fatalf("ISOWeekString returned %s for %s", weekDate, ides)
+	} else {
+		fmt.Println(err)
+	}
+	var birthday, _ = PROLEPTIC.New(2, 29, -48)
+	var period = Between(birthday, ides)
+	if period.String() != "P4Y15D" {
+		t.Fatalf("Period from %s to %s is %s", birthday, ides, period)
+	}
+	period = Between(ides, MinDate)
+	if period.Years() != 1644 || period.Months() != 9 || period.Days() != 17 {
+		t.Fatalf("Period from %s to %s is %s", ides, MinDate, period)
+	}
+	date, err = PROLEPTIC.AddMonths(ides, -1, MonthPolicy{})
+	handle(err, t)
+	if date != (Date{2, 15, -44}) {
+		t.Fatalf("Month before %s is %s", ides, date)
+	}
+	_, err = AddMonths(ides, 1, MonthPolicy{})
+	if err == nil {
+		t.Fatalf("AddMonths did not detect a result outside the standard range")
+	}
+
+	var bytes []byte
+	bytes, err = json.Marshal(ides)
+	handle(err, t)
+	if string(bytes) != `"-0044-03-15"` {
+		t.Fatalf("Wrong JSON for %s: %s", ides, bytes)
+	}
+	var value, _ = ides.Value()
+	if value != "0045-03-15 BC" {
+		t.Fatalf("Wrong SQL value for %s: %v", ides, value)
+	}
+	var dayZero, _ = PROLEPTIC.New(11, 24, -4713)
+	value, err = dayZero.Value()
+	if err != nil || value != "4714-11-24 BC" {
+		t.Fatalf("Wrong SQL value for %s: %v", dayZero, value)
+	}
+	date, _ = PROLEPTIC.Add(dayZero, -1)
+	_, err = date.Value()
+	if err == nil {
+		t.Fatalf("Value did not detect a date before the earliest Postgres date")
+	} else {
+		fmt.Println(err)
+	}
+	err = date.Scan("0045-03-15 00:00:00 BC")
+	handle(err, t)
+	if date != ides {
+		t.Fatalf("Scan of 0045-03-15 BC returned %s", date)
+	}
+	err = date.Scan("1500-06-01")
+	handle(err, t)
+	if date.Year() != 1500 {
+		t.Fatalf("Scan of 1500-06-01 returned %s", date)
+	}
+	err = date.Scan("0002-02-29 BC")
+	if err == nil {
+		t.Fatalf("Scan did not detect 29-Feb in a year that is not a leap year")
+	} else {
+		fmt.Println(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interchange
+// ----------------------------------------------------------------------------
+
+// Test_Interchange tests the conversion of dates to and from the day-number
+// systems of other software.
+func Test_Interchange(t *testing.T) {
+	type aTest struct {
+		name    string
+		date    string
+		convert func(Date) int
+		from    func(int) (Date, error)
+		serial  int
+	}
+	var data = []aTest{
+		{"JDN", "2024-10-16", Date.JulianDayNumber, FromJulianDayNumber, 2460600},
+		{"JDN 2000", "2000-01-01", Date.JulianDayNumber, FromJulianDayNumber, 2451545},
+		{"MJD epoch", "1858-11-17", Date.ModifiedJulianDay, FromModifiedJulianDay, 0},
+		{"MJD", "2024-10-16", Date.ModifiedJulianDay, FromModifiedJulianDay, 60599},
+		{"Unix epoch", "1970-01-01", Date.UnixDays, FromUnixDays, 0},
+		{"Unix before epoch", "1969-12-31", Date.UnixDays, FromUnixDays, -1},
+		{"Unix", "2024-10-16", Date.UnixDays, FromUnixDays, 20012},
+		{"SAS epoch", "1960-01-01", Date.SASDate, FromSASDate, 0},
+		{"SAS", "2024-10-16", Date.SASDate, FromSASDate, 23665},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date, err = Parse(ISO8601, tt.date)
+		handle(err, t)
+		if tt.convert(date) != tt.serial {
+			t.Fatalf("Date %s converts to %d, not %d", date, tt.convert(date), tt.serial)
+		}
+		var converted Date
+		converted, err = tt.from(tt.serial)
+		handle(err, t)
+		if converted != date {
+			t.Fatalf("Serial %d converts to %s, not %s", tt.serial, converted, date)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var _, err = FromUnixDays(MinDate.UnixDays() - 1)
+	if err == nil {
+		t.Fatalf("FromUnixDays did not detect a date before MinDate")
+	} else {
+		fmt.Println(err)
+	}
+}
+
+// Test_Excel tests the conversion of dates to and from Excel serial numbers.
+func Test_Excel(t *testing.T) {
+	type aTest struct {
+		name   string
+		date   string
+		serial int
+		error  bool
+	}
+	var data = []aTest{
+		{"1900 first", "1900-01-01", 1, false},
+		{"1900 before leap day", "1900-02-28", 59, false},
+		{"1900 after leap day", "1900-03-01", 61, false},
+		{"1900 recent", "2024-10-16", 45581, false},
+		{"1900 before system", "1899-12-31", 0, true},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date, err = Parse(ISO8601, tt.date)
+		handle(err, t)
+		var serial int
+		serial, err = date.ExcelSerial1900()
+		switch {
+		case tt.error && err == nil:
+			t.Fatalf("ExcelSerial1900 did not detect date %s outside the system", date)
+		case tt.error:
+			fmt.Println(err)
+		case err != nil:
+			t.Fatalf("ExcelSerial1900 incorrectly reported an error for %s: %s", date, err)
+		case serial != tt.serial:
+			t.Fatalf("Date %s has serial %d, not %d", date, serial, tt.serial)
+		default:
+			var converted, err = FromExcelSerial1900(serial)
+			handle(err, t)
+			if converted != date {
+				t.Fatalf("Serial %d converts to %s, not %s", serial, converted, date)
+			}
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var _, err = FromExcelSerial1900(ExcelFakeLeapDay)
+	if err == nil {
+		t.Fatalf("FromExcelSerial1900 did not detect 29-Feb-1900")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = FromExcelSerial1900(0)
+	if err == nil {
+		t.Fatalf("FromExcelSerial1900 did not detect serial 0")
+	}
+
+	var date, _ = Parse(ISO8601, "1904-01-01")
+	var serial int
+	serial, err = date.ExcelSerial1904()
+	handle(err, t)
+	if serial != 0 {
+		t.Fatalf("Date %s has 1904 serial %d, not 0", date, serial)
+	}
+	date, _ = Parse(ISO8601, "2024-10-16")
+	serial, _ = date.ExcelSerial1904()
+	var converted, _ = FromExcelSerial1904(serial)
+	if serial != 45581-1462 || converted != date {
+		t.Fatalf("Date %s has 1904 serial %d and converts back to %s", date, serial, converted)
+	}
+	date, _ = Parse(ISO8601, "1903-12-31")
+	_, err = date.ExcelSerial1904()
+	if err == nil {
+		t.Fatalf("ExcelSerial1904 did not detect a date before 1904")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test time
+// ----------------------------------------------------------------------------
+
+// Test_FromTime tests the conversion of instants into dates.
+func Test_FromTime(t *testing.T) {
+	var tokyo, err = time.LoadLocation("Asia/Tokyo")
+	handle(err, t)
+	var instant = time.Date(2024, time.October, 16, 23, 30, 0, 0, time.UTC)
+	var date Date
+	date, err = FromTime(instant)
+	handle(err, t)
+	if date.String() != "16-Oct-2024" {
+		t.Fatalf("Wrong date for %s: %s", instant, date)
+	}
+	date, err = FromTime(instant.In(tokyo))
+	handle(err, t)
+	if date.String() != "17-Oct-2024" {
+		t.Fatalf("Wrong date for %s: %s", instant.In(tokyo), date)
+	}
+	_, err = FromTime(time.Date(MaxYear+1, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err == nil {
+		t.Fatalf("FromTime did not detect a year above the range")
+	} else {
+		fmt.Println(err)
+	}
+
+	var month Month
+	month, err = FromTimeMonth(time.December)
+	handle(err, t)
+	if month != 12 || month.TimeMonth() != time.December {
+		t.Fatalf("Wrong conversion of time.December: %d", month)
+	}
+	var weekDay DayOfWeek
+	weekDay, err = FromTimeWeekday(time.Saturday)
+	handle(err, t)
+	if weekDay != SATURDAY || SUNDAY.TimeWeekday() != time.Sunday {
+		t.Fatalf("Wrong conversion of time.Saturday: %d", weekDay)
+	}
+	_, err = FromTimeWeekday(time.Weekday(7))
+	if err == nil {
+		t.Fatalf("FromTimeWeekday did not detect an invalid weekday")
+	}
+}
+
+// Test_StartOf tests the conversion of dates into instants, including days
+// on which a daylight saving transition skips or repeats midnight.
+func Test_StartOf(t *testing.T) {
+	type aTest struct {
+		name     string
+		location string
+		date     string
+		start    string
+		end      string
+		hours    float64
+	}
+	var data = []aTest{
+		{"UTC", "UTC", "2024-10-16", "2024-10-16T00:00:00Z", "2024-10-16T23:59:59.999999999Z", 24},
+		{"New York spring", "America/New_York", "2024-03-10",
+			"2024-03-10T00:00:00-05:00", "2024-03-10T23:59:59.999999999-04:00", 23},
+		{"New York fall", "America/New_York", "2024-11-03",
+			"2024-11-03T00:00:00-04:00", "2024-11-03T23:59:59.999999999-05:00", 25},
+		{"Havana skips midnight", "America/Havana", "2024-03-10",
+			"2024-03-10T01:00:00-04:00", "2024-03-10T23:59:59.999999999-04:00", 23},
+		{"Beirut skips midnight", "Asia/Beirut", "2024-03-31",
+			"2024-03-31T01:00:00+03:00", "2024-03-31T23:59:59.999999999+03:00", 23},
+		{"Havana repeats midnight", "America/Havana", "2024-11-03",
+			"2024-11-03T00:00:00-04:00", "2024-11-03T23:59:59.999999999-05:00", 25},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var loc, err = time.LoadLocation(tt.location)
+		handle(err, t)
+		var date Date
+		date, err = Parse(ISO8601, tt.date)
+		handle(err, t)
+		var start = date.StartOf(loc)
+		var end = date.EndOf(loc)
+		if start.Format(time.RFC3339Nano) != tt.start {
+			t.Fatalf("Start of %s in %s is %s, not %s", date, loc, start.Format(time.RFC3339Nano), tt.start)
+		}
+		if end.Format(time.RFC3339Nano) != tt.end {
+			t.Fatalf("End of %s in %s is %s, not %s", date, loc, end.Format(time.RFC3339Nano), tt.end)
+		}
+		var hours = end.Add(time.Nanosecond).Sub(start).Hours()
+		if hours != tt.hours {
+			t.Fatalf("Date %s in %s has %v hours, not %v", date, loc, hours, tt.hours)
+		}
+		var converted Date
+		converted, err = FromTime(start)
+		handle(err, t)
+		if converted != date {
+			t.Fatalf("Start of %s converts back to %s", date, converted)
+		}
+		converted, _ = FromTime(end)
+		if converted != date {
+			t.Fatalf("End of %s converts back to %s", date, converted)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var date, _ = New(2, 29, 2024)
+	var midnight = date.In(time.UTC)
+	if midnight != time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Wrong instant for %s: %s", date, midnight)
+	}
+	var end = MaxDate.EndOf(time.UTC)
+	if end.Year() != MaxYear || end.Day() != 31 {
+		t.Fatalf("Wrong end of MaxDate: %s", end)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test clock
+// ----------------------------------------------------------------------------
+
+// Test_Clock tests today's date from the system clock and a fake clock.
+func Test_Clock(t *testing.T) {
+	var tokyo, err = time.LoadLocation("Asia/Tokyo")
+	handle(err, t)
+	var expected Date
+	expected, err = FromTime(time.Now().In(tokyo))
+	handle(err, t)
+	var next, _ = expected.Increment()
+	if TodayIn(tokyo) != expected && TodayIn(tokyo) != next {
+		t.Fatalf("TodayIn returned %s, not %s", TodayIn(tokyo), expected)
+	}
+
+	var clock = NewFakeClock(time.Date(2024, time.February, 28, 23, 0, 0, 0, time.UTC))
+	var date = TodayFrom(clock)
+	if date.String() != "28-Feb-2024" {
+		t.Fatalf("Fake clock returned %s", date)
+	}
+	clock.Advance(time.Hour)
+	if TodayFrom(clock).String() != "29-Feb-2024" {
+		t.Fatalf("Fake clock advanced to %s", TodayFrom(clock))
+	}
+	clock.AdvanceDays(1)
+	if TodayFrom(clock).String() != "01-Mar-2024" {
+		t.Fatalf("Fake clock advanced to %s", TodayFrom(clock))
+	}
+	clock.Set(time.Date(2024, time.December, 31, 20, 0, 0, 0, time.UTC))
+	date = TodayFrom(NewFakeClock(clock.Now().In(tokyo)))
+	if date.String() != "01-Jan-2025" {
+		t.Fatalf("Fake clock in Tokyo returned %s", date)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test Julian calendar
+// ----------------------------------------------------------------------------
+
+// Test_JulianDate tests the conversion of Julian dates to Gregorian dates.
+func Test_JulianDate(t *testing.T) {
+	type aTest struct {
+		name      string
+		month     Month
+		day       Day
+		year      Year
+		gregorian string
+	}
+	var data = []aTest{
+		{"Catholic cutover", 10, 4, 1582, "1582-10-14"},
+		{"British cutover", 9, 2, 1752, "1752-09-13"},
+		{"Julian leap day", 2, 29, 1700, "1700-03-11"},
+		{"Russian revolution", 10, 25, 1917, "1917-11-07"},
+		{"Ides of March", 3, 15, -43, "-0043-03-13"},
+		{"calendars agree", 3, 1, 200, "0200-03-01"},
+		{"Julian Day zero", 1, 1, -4712, "-4713-11-24"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var julianDate, err = NewJulian(tt.month, tt.day, tt.year)
+		handle(err, t)
+		var expected Date
+		expected, err = PROLEPTIC.Parse(ISO8601, tt.gregorian)
+		handle(err, t)
+		var date Date
+		date, err = julianDate.Date()
+		handle(err, t)
+		if date != expected {
+			t.Fatalf("Julian date %s is %s, not %s", julianDate, date, expected)
+		}
+		var converted JulianDate
+		converted, err = JulianFromDate(date)
+		handle(err, t)
+		if converted != julianDate {
+			t.Fatalf("Date %s is Julian date %s, not %s", date, converted, julianDate)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var dayZero, _ = NewJulian(1, 1, -4712)
+	if dayZero.DayNumber() != julianDayOffset {
+		t.Fatalf("Julian date %s has day number %d, not %d", dayZero, dayZero.DayNumber(), julianDayOffset)
+	}
+	if !IsJulianLeapYear(1900) || IsJulianLeapYear(1901) || !IsJulianLeapYear(-4) {
+		t.Fatalf("Julian leap years are not identified correctly")
+	}
+	var _, err = NewJulian(2, 30, 1900)
+	if err == nil {
+		t.Fatalf("NewJulian did not detect 30-Feb")
+	} else {
+		fmt.Println(err)
+	}
+	var last, _ = NewJulian(12, 31, ProlepticMaxYear)
+	_, err = last.Date()
+	if err == nil {
+		t.Fatalf("Date did not detect a Julian date after MaxDate")
+	}
+	for dayNumber := -800000; dayNumber < 900000; dayNumber += 997 {
+		var julianDate, err = JulianFromDayNumber(dayNumber)
+		handle(err, t)
+		if julianDate.DayNumber() != dayNumber {
+			t.Fatalf("Day number %d converts to %s with day number %d", dayNumber, julianDate, julianDate.DayNumber())
+		}
+	}
+}
+
+// Test_Cutover tests the resolution of historical dates in regions that
+// changed from the Julian to the Gregorian calendar.
+func Test_Cutover(t *testing.T) {
+	type aTest struct {
+		name     string
+		region   string
+		month    Month
+		day      Day
+		year     Year
+		expected string
+	}
+	var data = []aTest{
+		{"last Catholic Julian date", "Spain", 10, 4, 1582, "1582-10-14"},
+		{"first Catholic Gregorian date", "Italy", 10, 15, 1582, "1582-10-15"},
+		{"Catholic skipped date", "Poland", 10, 10, 1582, ""},
+		{"Newton's birth", "Britain", 12, 25, 1642, "1643-01-04"},
+		{"British leap day", "Britain", 2, 29, 1700, "1700-03-11"},
+		{"British skipped date", "Britain", 9, 3, 1752, ""},
+		{"British first Gregorian date", "Britain", 9, 14, 1752, "1752-09-14"},
+		{"October revolution", "Russia", 10, 25, 1917, "1917-11-07"},
+		{"Russian skipped date", "Russia", 2, 1, 1918, ""},
+		{"Russian Gregorian date", "Russia", 2, 14, 1918, "1918-02-14"},
+		{"Gregorian leap day not Julian", "Greece", 2, 29, 1923, ""},
+		{"unknown region", "Atlantis", 1, 1, 1600, ""},
+	}
+
+	var table = DefaultCutovers()
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var date, err = table.Resolve(tt.region, tt.month, tt.day, tt.year)
+		switch {
+		case tt.expected == "" && err == nil:
+			t.Fatalf("Resolve did not report an error for %d/%d/%d in %s", tt.month, tt.day, tt.year, tt.region)
+		case tt.expected == "":
+			fmt.Println(err)
+		case err != nil:
+			t.Fatalf("Resolve incorrectly reported an error: %s", err)
+		case date.Format(ISO8601) != tt.expected:
+			t.Fatalf("Resolve returned %s, not %s", date.Format(ISO8601), tt.expected)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	for region, cutover := range table {
+		var lastJulian, err = cutover.LastJulian().Date()
+		handle(err, t)
+		var next, _ = PROLEPTIC.Add(lastJulian, 1)
+		if next != cutover.First() || !cutover.IsJulian(lastJulian) || cutover.IsJulian(next) {
+			t.Fatalf("Cutover in %s is not consecutive", region)
+		}
+	}
+	var britain = table["Britain"]
+	if britain.DaysSkipped() != 11 || table["Spain"].DaysSkipped() != 10 || table["Greece"].DaysSkipped() != 13 {
+		t.Fatalf("Wrong number of days skipped")
+	}
+	var _, err = NewCutover("Nowhere", 3, 1, 200)
+	if err == nil {
+		t.Fatalf("NewCutover did not detect a cutover that skips no dates")
+	} else {
+		fmt.Println(err)
+	}
+	table["Scotland"], err = NewCutover("Scotland", 9, 14, 1752)
+	handle(err, t)
+	if _, err = table.Resolve("Scotland", 9, 5, 1752); err == nil {
+		t.Fatalf("Added cutover did not reject a skipped date")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test Easter
+// ----------------------------------------------------------------------------
+
+// Test_Easter tests the computation of Western and Orthodox Easter.
+func Test_Easter(t *testing.T) {
+	type aTest struct {
+		name     string
+		year     Year
+		western  string
+		orthodox string
+	}
+	var data = []aTest{
+		{"2024", 2024, "2024-03-31", "2024-05-05"},
+		{"2025 same day", 2025, "2025-04-20", "2025-04-20"},
+		{"2023", 2023, "2023-04-09", "2023-04-16"},
+		{"2000", 2000, "2000-04-23", "2000-04-30"},
+		{"earliest", 1818, "1818-03-22", "1818-04-26"},
+		{"latest", 1943, "1943-04-25", "1943-04-25"},
+		{"2038", 2038, "2038-04-25", "2038-04-25"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var easter, err = Easter(tt.year)
+		handle(err, t)
+		if easter.Format(ISO8601) != tt.western {
+			t.Fatalf("Easter %d is %s, not %s", tt.year, easter.Format(ISO8601), tt.western)
+		}
+		easter, err = OrthodoxEaster(tt.year)
+		handle(err, t)
+		if easter.Format(ISO8601) != tt.orthodox {
+			t.Fatalf("Orthodox Easter %d is %s, not %s", tt.year, easter.Format(ISO8601), tt.orthodox)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var earliest, _ = PROLEPTIC.New(3, 22, 0)
+	var latest, _ = PROLEPTIC.New(4, 25, 0)
+	for year := Year(MinYear); year <= MaxYear; year += 7 {
+		var easter, err = Easter(year)
+		handle(err, t)
+		var weekDay, _ = easter.WeekDay()
+		earliest.year = year
+		latest.year = year
+		if weekDay != SUNDAY || easter.Before(earliest) || easter.After(latest) {
+			t.Fatalf("Easter %d is %s", year, easter)
+		}
+		easter, err = OrthodoxEaster(year)
+		handle(err, t)
+		weekDay, _ = easter.WeekDay()
+		if weekDay != SUNDAY {
+			t.Fatalf("Orthodox Easter %d is %s", year, easter)
+		}
+	}
+	var _, err = Easter(ProlepticMaxYear + 1)
+	if err == nil {
+		t.Fatalf("Easter did not detect a year above the range")
+	}
+}
+
+// Test_Feasts tests the dates of the movable feasts.
+func Test_Feasts(t *testing.T) {
+	var expected = map[string]string{
+		"Shrove Tuesday":  "2024-02-13",
+		"Ash Wednesday":   "2024-02-14",
+		"Palm Sunday":     "2024-03-24",
+		"Maundy Thursday": "2024-03-28",
+		"Good Friday":     "2024-03-29",
+		"Holy Saturday":   "2024-03-30",
+		"Easter Sunday":   "2024-03-31",
+		"Easter Monday":   "2024-04-01",
+		"Ascension Day":   "2024-05-09",
+		"Whit Sunday":     "2024-05-19",
+		"Whit Monday":     "2024-05-20",
+		"Trinity Sunday":  "2024-05-26",
+		"Corpus Christi":  "2024-05-30",
+	}
+	if len(Feasts) != len(expected) {
+		t.Fatalf("Feasts has %d entries, not %d", len(Feasts), len(expected))
+	}
+	for _, feast := range Feasts {
+		var date, err = feast.Date(2024)
+		handle(err, t)
+		if date.Format(ISO8601) != expected[feast.Name] {
+			t.Errorf("%s 2024 is %s, not %s", feast, date.Format(ISO8601), expected[feast.Name])
+		}
+	}
+	var date, err = GoodFriday.OrthodoxDate(2024)
+	handle(err, t)
+	if date.Format(ISO8601) != "2024-05-03" {
+		t.Errorf("Orthodox Good Friday 2024 is %s", date.Format(ISO8601))
+	}
+}
@@ -0,0 +1,154 @@
+// ----------------------------------------------------------------------------
+//
+// Easter
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the computation of Easter Sunday and the movable
+// feasts whose dates are a fixed number of days from Easter Sunday.
+//
+// Western churches compute Easter with the Gregorian computus.  Orthodox
+// churches compute Easter with the Julian computus, which yields a date in the
+// Julian calendar that is converted here into a Gregorian date.  Both
+// computations are applied proleptically to every year of the PROLEPTIC
+// mode, and the dates are created in that mode.
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Feast is a movable feast that falls a fixed number of days from Easter
+// Sunday.
+type Feast struct {
+	Name   string
+	Offset int // days after Easter Sunday, negative for days before
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+var (
+	ShroveTuesday  = Feast{"Shrove Tuesday", -47}
+	AshWednesday   = Feast{"Ash Wednesday", -46}
+	PalmSunday     = Feast{"Palm Sunday", -7}
+	MaundyThursday = Feast{"Maundy Thursday", -3}
+	GoodFriday     = Feast{"Good Friday", -2}
+	HolySaturday   = Feast{"Holy Saturday", -1}
+	EasterSunday   = Feast{"Easter Sunday", 0}
+	EasterMonday   = Feast{"Easter Monday", 1}
+	AscensionDay   = Feast{"Ascension Day", 39}
+	WhitSunday     = Feast{"Whit Sunday", 49}
+	WhitMonday     = Feast{"Whit Monday", 50}
+	TrinitySunday  = Feast{"Trinity Sunday", 56}
+	CorpusChristi  = Feast{"Corpus Christi", 60}
+)
+
+// Feasts is the table of movable feasts in the order in which they occur.
+var Feasts = []Feast{
+	ShroveTuesday,
+	AshWednesday,
+	PalmSunday,
+	MaundyThursday,
+	GoodFriday,
+	HolySaturday,
+	EasterSunday,
+	EasterMonday,
+	AscensionDay,
+	WhitSunday,
+	WhitMonday,
+	TrinitySunday,
+	CorpusChristi,
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// Easter returns the date of Easter Sunday in the year according to the
+// Gregorian computus.  This is the anonymous Gregorian algorithm from:
+// Jean Meeus, Astronomical Algorithms, 2nd ed. (Richmond, VA: Willmann-Bell,
+// 1998), p. 67.  The divisions are rounded toward negative infinity so that
+// the algorithm also applies to years before 1 AD.
+func Easter(year Year) (Date, error) {
+	var err = PROLEPTIC.isYear(year)
+	if err != nil {
+		return Date{}, err
+	}
+	var y = int(year)
+	var a = floorMod(y, 19)
+	var b = floorDiv(y, 100)
+	var c = floorMod(y, 100)
+	var d = floorDiv(b, 4)
+	var e = floorMod(b, 4)
+	var f = floorDiv(b+8, 25)
+	var g = floorDiv(b-f+1, 3)
+	var h = floorMod(19*a+b-d-g+15, 30)
+	var i = c / 4
+	var k = c % 4
+	var l = floorMod(32+2*e+2*i-h-k, 7)
+	var m = (a + 11*h + 22*l) / 451
+	var n = h + l - 7*m + 114
+	return PROLEPTIC.New(Month(n/31), Day(n%31+1), year)
+}
+
+// OrthodoxEaster returns the Gregorian date of Easter Sunday in the year
+// according to the Julian computus used by the Orthodox churches.  This
+// algorithm is from: Jean Meeus, Astronomical Algorithms, 2nd ed. (Richmond,
+// VA: Willmann-Bell, 1998), p. 69.  An error is returned if the Gregorian
+// date is outside the range of the PROLEPTIC mode.
+func OrthodoxEaster(year Year) (Date, error) {
+	var err = PROLEPTIC.isYear(year)
+	if err != nil {
+		return Date{}, err
+	}
+	var y = int(year)
+	var a = floorMod(y, 4)
+	var b = floorMod(y, 7)
+	var c = floorMod(y, 19)
+	var d = (19*c + 15) % 30
+	var e = floorMod(2*a+4*b-d+34, 7)
+	var n = d + e + 114
+	var julianDate JulianDate
+	julianDate, err = NewJulian(Month(n/31), Day(n%31+1), year)
+	if err != nil {
+		return Date{}, err
+	}
+	return julianDate.Date()
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Date returns the date of the feast in the year, relative to Easter Sunday
+// according to the Gregorian computus.
+func (feast Feast) Date(year Year) (Date, error) {
+	var easter, err = Easter(year)
+	if err != nil {
+		return Date{}, err
+	}
+	return PROLEPTIC.Add(easter, feast.Offset)
+}
+
+// OrthodoxDate returns the Gregorian date of the feast in the year, relative
+// to Easter Sunday according to the Julian computus.
+func (feast Feast) OrthodoxDate(year Year) (Date, error) {
+	var easter, err = OrthodoxEaster(year)
+	if err != nil {
+		return Date{}, err
+	}
+	return PROLEPTIC.Add(easter, feast.Offset)
+}
+
+// String returns the name of the feast.
+func (feast Feast) String() string {
+	return feast.Name
+}
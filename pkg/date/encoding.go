@@ -0,0 +1,87 @@
+// ----------------------------------------------------------------------------
+//
+// Encoding
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the encoding of dates as JSON.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+var jsonNull = []byte("null")
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// isoString returns the date in the ISO 8601 format YYYY-MM-DD.
+func (date Date) isoString() string {
+	return date.Format(ISO8601)
+}
+
+// parseISO converts a string in the ISO 8601 format YYYY-MM-DD into a date.
+// The error identifies the component of the string that is not valid.
+func parseISO(value string) (Date, error) {
+	return Parse(ISO8601, value)
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// MarshalJSON implements the json.Marshaler interface.  A valid date is
+// encoded as a JSON string in the ISO 8601 format YYYY-MM-DD.  The zero
+// value of Date is encoded as null.  Any other invalid date is an error.
+func (date Date) MarshalJSON() ([]byte, error) {
+	if date == (Date{}) {
+		return jsonNull, nil
+	}
+	var err = IsADate(date)
+	if err != nil {
+		return nil, errors.New("date.MarshalJSON: " + err.Error())
+	}
+	return json.Marshal(date.isoString())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.  The value must
+// be a JSON string in the ISO 8601 format YYYY-MM-DD or null.  A null value
+// leaves the date unchanged, consistent with the encoding/json package.
+// The decoded date is validated with IsDate.
+func (date *Date) UnmarshalJSON(data []byte) error {
+	var err error
+	var value string
+	var result Date
+
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.New("date.UnmarshalJSON: date must be a JSON string: " + string(data))
+	}
+	result, err = parseISO(value)
+	if err != nil {
+		return errors.New("date.UnmarshalJSON: " + err.Error())
+	}
+	*date = result
+	return nil
+}
@@ -0,0 +1,242 @@
+// ----------------------------------------------------------------------------
+//
+// Format
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the formatting of dates with layouts.
+//
+// A layout is a string of pattern letters and literal text.  A run of the
+// same pattern letter is replaced by a component of the date:
+//
+//	d      day of the month without padding            6, 16
+//	dd     day of the month, two digits                06, 16
+//	M      month number without padding                1, 10
+//	MM     month number, two digits                    01, 10
+//	MMM    month abbreviation                          Jan, Oct
+//	MMMM   full month name                             January, October
+//	yy     last two digits of the year                 24
+//	yyyy   year, at least four digits                  2024
+//	D      day of the year without padding             1, 290
+//	DDD    day of the year, three digits               001, 290
+//	EEE    weekday abbreviation                        Mon, Wed
+//	EEEE   full weekday name                           Monday, Wednesday
+//
+// For numeric components other than yy, the number of letters is the minimum
+// number of digits, so y is the year without padding.  Text enclosed in single
+// quotes is copied literally, and two single quotes produce one quote.  All
+// other characters, including letters that are not pattern letters, are
+// copied unchanged.
+//
+// For example, with the date 16-Oct-2024:
+//
+//	"yyyy-MM-dd"        2024-10-16
+//	"MM/dd/yyyy"        10/16/2024
+//	"d MMMM yyyy"       16 October 2024
+//	"EEE d MMM"         Wed 16 Oct
+//	"yyyy-DDD"          2024-290
+//	"dd-MMM-yyyy"       16-Oct-2024, the same as String
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Layouts for common date formats.
+const (
+	ISO8601      = "yyyy-MM-dd"
+	ISO8601Basic = "yyyyMMdd"
+	DateBench    = "dd-MMM-yyyy"
+	USSlash      = "MM/dd/yyyy"
+	EUDot        = "dd.MM.yyyy"
+)
+
+var namesMonthFull = []string{
+	"January",
+	"February",
+	"March",
+	"April",
+	"May",
+	"June",
+	"July",
+	"August",
+	"September",
+	"October",
+	"November",
+	"December",
+}
+
+var namesWeekDay = []string{
+	"Sunday",
+	"Monday",
+	"Tuesday",
+	"Wednesday",
+	"Thursday",
+	"Friday",
+	"Saturday",
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// MonthFullName returns the full name of the month.
+func MonthFullName(month Month) string {
+	assert.Precondition(isMonth(month))
+	return namesMonthFull[month-1]
+}
+
+// WeekDayName returns the full name of the day of the week.
+func WeekDayName(dayOfWeek DayOfWeek) string {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+	return namesWeekDay[dayOfWeek]
+}
+
+// isPatternLetter returns true if the character is a letter with a meaning
+// in a layout.
+func isPatternLetter(ch byte) bool {
+	return ch == 'd' || ch == 'M' || ch == 'y' || ch == 'D' || ch == 'E'
+}
+
+// appendInt appends the decimal representation of value to buffer, padded
+// with leading zeros to at least width digits.  It does not allocate memory
+// beyond growing the buffer.
+func appendInt(buffer []byte, value int, width int) []byte {
+	var digits [20]byte
+	var index = len(digits)
+
+	if value < 0 {
+		buffer = append(buffer, '-')
+		value = -value
+	}
+	for value >= 10 {
+		index--
+		digits[index] = byte('0' + value%10)
+		value /= 10
+	}
+	index--
+	digits[index] = byte('0' + value)
+	for i := len(digits) - index; i < width; i++ {
+		buffer = append(buffer, '0')
+	}
+	return append(buffer, digits[index:]...)
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Format returns the date formatted according to the layout.  See the
+// description of layouts at the top of this file.
+func (date Date) Format(layout string) string {
+	var buffer = make([]byte, 0, len(layout)+10)
+	buffer = date.AppendFormat(buffer, layout)
+	return string(buffer)
+}
+
+// AppendFormat is like Format but appends the formatted date to buffer and
+// returns the extended buffer.  It does not allocate memory when buffer has
+// sufficient capacity.
+func (date Date) AppendFormat(buffer []byte, layout string) []byte {
+	assert.Precondition(IsADate(date))
+
+	var index = 0
+	for index < len(layout) {
+		var ch = layout[index]
+		//
+		// Quoted literal text
+		//
+		if ch == '\'' {
+			var end = index + 1
+			if end < len(layout) && layout[end] == '\'' {
+				buffer = append(buffer, '\'')
+				index = end + 1
+				continue
+			}
+			for end < len(layout) {
+				if layout[end] == '\'' {
+					if end+1 < len(layout) && layout[end+1] == '\'' {
+						buffer = append(buffer, '\'')
+						end += 2
+						continue
+					}
+					break
+				}
+				buffer = append(buffer, layout[end])
+				end++
+			}
+			index = end + 1
+			continue
+		}
+		//
+		// Literal character
+		//
+		if !isPatternLetter(ch) {
+			buffer = append(buffer, ch)
+			index++
+			continue
+		}
+		//
+		// Pattern
+		//
+		var count = 1
+		for index+count < len(layout) && layout[index+count] == ch {
+			count++
+		}
+		buffer = date.appendField(buffer, ch, count)
+		index += count
+	}
+	return buffer
+}
+
+// appendField appends the component of the date specified by the pattern
+// letter and its count.
+func (date Date) appendField(buffer []byte, letter byte, count int) []byte {
+	switch letter {
+	case 'd':
+		buffer = appendInt(buffer, int(date.day), count)
+	case 'M':
+		switch {
+		case count <= 2:
+			buffer = appendInt(buffer, int(date.month), count)
+		case count == 3:
+			buffer = append(buffer, MonthName(date.month)...)
+		default:
+			buffer = append(buffer, MonthFullName(date.month)...)
+		}
+	case 'y':
+		if count == 2 {
+			var year = int(date.year) % 100
+			if year < 0 {
+				year = -year
+			}
+			buffer = appendInt(buffer, year, 2)
+		} else {
+			buffer = appendInt(buffer, int(date.year), count)
+		}
+	case 'D':
+		buffer = appendInt(buffer, int(DayYear(date)), count)
+	case 'E':
+		var weekDay, _ = date.WeekDay()
+		if count <= 3 {
+			buffer = append(buffer, namesWeekDay[weekDay][0:3]...)
+		} else {
+			buffer = append(buffer, namesWeekDay[weekDay]...)
+		}
+	}
+	return buffer
+}
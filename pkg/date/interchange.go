@@ -0,0 +1,183 @@
+// ----------------------------------------------------------------------------
+//
+// Interchange
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the conversion of dates to and from the day-number
+// systems used by other software.  Each system counts days from its own
+// epoch, so each conversion adds a fixed offset to the absolute date.
+//
+//	System                 Epoch (day 0)            Notes
+//	Julian Day Number      1-Jan-4713 BC (Julian)   astronomy; noon-based day
+//	Modified Julian Day    17-Nov-1858              JDN - 2400001
+//	Unix epoch days        1-Jan-1970               Arrow and Parquet date32
+//	SAS date               1-Jan-1960
+//	Excel 1900 system      31-Dec-1899              serial 60 is 29-Feb-1900
+//	Excel 1904 system      1-Jan-1904
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The offsets are the absolute dates of the epochs of the day-number
+// systems, so serial = absoluteDate - offset.
+const (
+	julianDayOffset         = -2305813 // 1-Jan-1601 is JDN 2305814
+	modifiedJulianDayOffset = 94188    // 17-Nov-1858
+	unixDaysOffset          = 134775   // 1-Jan-1970
+	sasDateOffset           = 131122   // 1-Jan-1960
+	excel1900Offset         = 109206   // 30-Dec-1899, for dates from 1-Mar-1900
+	excel1904Offset         = 110668   // 1-Jan-1904
+)
+
+const (
+	// ExcelFakeLeapDay is the serial number Excel assigns to 29-Feb-1900, a
+	// date that does not exist.  Excel treats 1900 as a leap year for
+	// compatibility with Lotus 1-2-3.
+	ExcelFakeLeapDay = 60
+	// ExcelMaxSerial1900 is the serial number of 31-Dec-9999, the last date
+	// Excel supports, in the 1900 system.
+	ExcelMaxSerial1900 = 2958465
+	// ExcelMaxSerial1904 is the serial number of 31-Dec-9999 in the 1904
+	// system.
+	ExcelMaxSerial1904 = 2957003
+)
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// JulianDayNumber returns the Julian Day Number of the date, the number of
+// days since 1-Jan-4713 BC in the Julian calendar.  It is the number of the
+// Julian day that begins at noon on the date.
+func (date Date) JulianDayNumber() int {
+	return date.DayNumber() - julianDayOffset
+}
+
+// ModifiedJulianDay returns the Modified Julian Day of the date, the number
+// of days since 17-Nov-1858.
+func (date Date) ModifiedJulianDay() int {
+	return date.DayNumber() - modifiedJulianDayOffset
+}
+
+// UnixDays returns the number of days since 1-Jan-1970, as used by the
+// Arrow and Parquet date32 types.  Dates before 1970 are negative.
+func (date Date) UnixDays() int {
+	return date.DayNumber() - unixDaysOffset
+}
+
+// SASDate returns the SAS date value of the date, the number of days since
+// 1-Jan-1960.  Dates before 1960 are negative.
+func (date Date) SASDate() int {
+	return date.DayNumber() - sasDateOffset
+}
+
+// ExcelSerial1900 returns the serial number of the date in the Excel 1900
+// date system, where 1-Jan-1900 is 1.  Excel counts a nonexistent 29-Feb-1900
+// as serial 60, so dates from 1-Mar-1900 are one more than their count of
+// days from 31-Dec-1899.  An error is returned for dates before 1-Jan-1900 or
+// after 31-Dec-9999, which Excel cannot represent.
+func (date Date) ExcelSerial1900() (int, error) {
+	var serial = date.DayNumber() - excel1900Offset
+	if serial <= ExcelFakeLeapDay {
+		serial--
+	}
+	if serial < 1 || serial > ExcelMaxSerial1900 {
+		return 0, errors.New("date.ExcelSerial1900: date " + date.String() +
+			" is outside the Excel 1900 date system, 01-Jan-1900 through 31-Dec-9999")
+	}
+	return serial, nil
+}
+
+// ExcelSerial1904 returns the serial number of the date in the Excel 1904
+// date system, where 1-Jan-1904 is 0.  An error is returned for dates before
+// 1-Jan-1904 or after 31-Dec-9999, which Excel cannot represent.
+func (date Date) ExcelSerial1904() (int, error) {
+	var serial = date.DayNumber() - excel1904Offset
+	if serial < 0 || serial > ExcelMaxSerial1904 {
+		return 0, errors.New("date.ExcelSerial1904: date " + date.String() +
+			" is outside the Excel 1904 date system, 01-Jan-1904 through 31-Dec-9999")
+	}
+	return serial, nil
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// FromJulianDayNumber returns the date with the Julian Day Number.
+func FromJulianDayNumber(julianDayNumber int) (Date, error) {
+	return fromSerial("FromJulianDayNumber", julianDayNumber, julianDayOffset)
+}
+
+// FromModifiedJulianDay returns the date with the Modified Julian Day.
+func FromModifiedJulianDay(modifiedJulianDay int) (Date, error) {
+	return fromSerial("FromModifiedJulianDay", modifiedJulianDay, modifiedJulianDayOffset)
+}
+
+// FromUnixDays returns the date that is the number of days after 1-Jan-1970.
+func FromUnixDays(days int) (Date, error) {
+	return fromSerial("FromUnixDays", days, unixDaysOffset)
+}
+
+// FromSASDate returns the date with the SAS date value.
+func FromSASDate(days int) (Date, error) {
+	return fromSerial("FromSASDate", days, sasDateOffset)
+}
+
+// FromExcelSerial1900 returns the date with the serial number in the Excel
+// 1900 date system.  Serial 60, which Excel displays as 29-Feb-1900, is an
+// error because the date does not exist.
+func FromExcelSerial1900(serial int) (Date, error) {
+	switch {
+	case serial < 1 || serial > ExcelMaxSerial1900:
+		return Date{}, errors.New("date.FromExcelSerial1900: serial number must be from 1 through " +
+			strconv.Itoa(ExcelMaxSerial1900) + ", not " + strconv.Itoa(serial))
+	case serial == ExcelFakeLeapDay:
+		return Date{}, errors.New("date.FromExcelSerial1900: serial number 60 is 29-Feb-1900, " +
+			"which does not exist")
+	case serial < ExcelFakeLeapDay:
+		serial++
+	}
+	return fromSerial("FromExcelSerial1900", serial, excel1900Offset)
+}
+
+// FromExcelSerial1904 returns the date with the serial number in the Excel
+// 1904 date system.
+func FromExcelSerial1904(serial int) (Date, error) {
+	if serial < 0 || serial > ExcelMaxSerial1904 {
+		return Date{}, errors.New("date.FromExcelSerial1904: serial number must be from 0 through " +
+			strconv.Itoa(ExcelMaxSerial1904) + ", not " + strconv.Itoa(serial))
+	}
+	return fromSerial("FromExcelSerial1904", serial, excel1904Offset)
+}
+
+// fromSerial converts a serial number in a day-number system with the
+// offset into a date.  An error is returned if the date is outside the range
+// of dates.
+func fromSerial(name string, serial int, offset int) (Date, error) {
+	var dayNumber = serial + offset
+	if dayNumber < MinDayNumber || dayNumber > MaxDayNumber {
+		var message = "date." + name + ": " + strconv.Itoa(serial) + " is outside the range of dates, " +
+			MinDate.String() + " through " + MaxDate.String()
+		return Date{}, errors.New(message)
+	}
+	return FromDayNumber(dayNumber)
+}
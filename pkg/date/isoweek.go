@@ -0,0 +1,293 @@
+// ----------------------------------------------------------------------------
+//
+// ISO Week
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the ISO 8601 week date.  An ISO week starts on Monday.
+// Week 1 of a week-year is the week containing the first Thursday of the
+// calendar year, so the week-year of a date near New Year may differ from the
+// calendar year.  For example, 30-Dec-2024 is in 2025-W01, and 1-Jan-2021 is
+// in 2020-W53.  A week-year has 52 or 53 weeks.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ISOWeek represents a week in an ISO 8601 week-year.
+//
+//	1 <= week <= WeeksInISOYear(year)
+type ISOWeek struct {
+	year Year
+	week int
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// isoWeekDay converts a day of the week to the ISO 8601 weekday number, which
+// is 1 for Monday through 7 for Sunday.
+func isoWeekDay(dayOfWeek DayOfWeek) int {
+	return (int(dayOfWeek)+6)%7 + 1
+}
+
+// isISOWeek returns nil if the week is a valid week of the week-year.
+// Otherwise, it returns an error.
+func isISOWeek(year Year, week int) error {
+	var err = isYear(year)
+	if err != nil {
+		return err
+	}
+	var weeks = WeeksInISOYear(year)
+	switch {
+	case week < 1:
+		err = errors.New("ISO week cannot be less than 1: " + strconv.Itoa(week))
+	case week > weeks:
+		err = errors.New("ISO week cannot be greater than " + strconv.Itoa(weeks) +
+			" in week-year " + strconv.Itoa(int(year)) + ": " + strconv.Itoa(week))
+	}
+	return err
+}
+
+// WeeksInISOYear returns the number of weeks in the ISO week-year, either 52
+// or 53.  A week-year has 53 weeks when 1-Jan is a Thursday, or when the year
+// is a leap year and 1-Jan is a Wednesday.
+func WeeksInISOYear(year Year) int {
+	assert.Precondition(isYear(year))
+	var jan1, _ = New(1, 1, year)
+	var weekDay, _ = jan1.WeekDay()
+	var weeks = 52
+	if weekDay == THURSDAY || (weekDay == WEDNESDAY && IsLeapYear(year)) {
+		weeks = 53
+	}
+	return weeks
+}
+
+// NewISOWeek returns the ISO week for the week-year and week number.
+func NewISOWeek(year Year, week int) (ISOWeek, error) {
+	var err = isISOWeek(year, week)
+	if err != nil {
+		return ISOWeek{}, err
+	}
+	var isoWeek = ISOWeek{
+		year: year,
+		week: week,
+	}
+	return isoWeek, nil
+}
+
+// ParseISOWeek converts a string in the form YYYY-Www, for example 2024-W42,
+// into an ISO week.  The basic form YYYYWww is also accepted.
+func ParseISOWeek(value string) (ISOWeek, error) {
+	var year, week, rest, _, err = parseISOWeekPrefix(value)
+	if err == nil && rest != "" {
+		err = errors.New("unexpected text " + strconv.Quote(rest))
+	}
+	if err != nil {
+		return ISOWeek{}, errors.New("date.ParseISOWeek: cannot parse " + strconv.Quote(value) + ": " + err.Error())
+	}
+	return NewISOWeek(year, week)
+}
+
+// ParseISOWeekDate converts a string in the form YYYY-Www-D, for example
+// 2024-W42-3, into a date.  The weekday D is 1 for Monday through 7 for
+// Sunday.  The basic form YYYYWwwD is also accepted.
+func ParseISOWeekDate(value string) (Date, error) {
+	var fail = func(err error) (Date, error) {
+		return Date{}, errors.New("date.ParseISOWeekDate: cannot parse " + strconv.Quote(value) + ": " +
+			err.Error())
+	}
+	var year, week, rest, extended, err = parseISOWeekPrefix(value)
+	if err != nil {
+		return fail(err)
+	}
+	if extended {
+		if len(rest) == 0 || rest[0] != '-' {
+			return fail(errors.New("expected '-' before weekday"))
+		}
+		rest = rest[1:]
+	}
+	if len(rest) != 1 || rest[0] < '1' || rest[0] > '7' {
+		return fail(errors.New("weekday must be a digit from 1 to 7"))
+	}
+	var isoWeek ISOWeek
+	isoWeek, err = NewISOWeek(year, week)
+	if err != nil {
+		return fail(err)
+	}
+	var date Date
+	date, err = isoWeek.Date(int(rest[0] - '0'))
+	if err != nil {
+		return fail(err)
+	}
+	return date, nil
+}
+
+// parseISOWeekPrefix reads the week-year and week from the start of an ISO
+// week string and returns the remaining text and whether the string is in
+// the extended form.
+func parseISOWeekPrefix(value string) (Year, int, string, bool, error) {
+	var err error
+	var year int
+	var week int
+	var position int
+
+	year, position, err = parseNumber(value, 0, 4, 4)
+	if err != nil {
+		return 0, 0, "", false, errors.New("invalid week-year: " + err.Error())
+	}
+	var extended = position < len(value) && value[position] == '-'
+	if extended {
+		position++
+	}
+	if position >= len(value) || value[position] != 'W' {
+		return 0, 0, "", false, errors.New("expected 'W' at position " + strconv.Itoa(position))
+	}
+	week, position, err = parseNumber(value, position+1, 2, 2)
+	if err != nil {
+		return 0, 0, "", false, errors.New("invalid week: " + err.Error())
+	}
+	var rest = value[position:]
+	if !extended && len(rest) > 0 && rest[0] == '-' {
+		return 0, 0, "", false, errors.New("extended and basic forms cannot be mixed")
+	}
+	return Year(year), week, rest, extended, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Date
+// ----------------------------------------------------------------------------
+
+// ISOWeek returns the ISO week containing the date and the ISO weekday of the
+// date, 1 for Monday through 7 for Sunday.  An error is returned if the
+// week-year is outside the range of dates, as for a date created in the
+// PROLEPTIC mode before 1601.
+func (date Date) ISOWeek() (ISOWeek, int, error) {
+	assert.Precondition(IsADate(date))
+
+	var weekDay, err = date.WeekDay()
+	if err != nil {
+		return ISOWeek{}, 0, err
+	}
+	var isoDay = isoWeekDay(weekDay)
+	//
+	// The Thursday of the week determines the week-year.
+	//
+	var thursday Date
+	thursday, err = Add(date, 4-isoDay)
+	if err != nil {
+		return ISOWeek{}, 0, err
+	}
+	var week = (int(DayYear(thursday))-1)/7 + 1
+	var isoWeek ISOWeek
+	isoWeek, err = NewISOWeek(thursday.year, week)
+	// Postcondition:
+	//   err != nil or isoWeek.Date(isoDay) = date
+	return isoWeek, isoDay, err
+}
+
+// ISOWeekString returns the date as an ISO 8601 week date in the form
+// YYYY-Www-D, for example 2024-W42-3.  As with ISOWeek, an error is returned
+// if the week-year is outside the range of dates.
+func (date Date) ISOWeekString() (string, error) {
+	var isoWeek, isoDay, err = date.ISOWeek()
+	if err != nil {
+		return "", errors.New("date.ISOWeekString: date " + date.String() + " has no ISO week: " + err.Error())
+	}
+	return isoWeek.String() + "-" + strconv.Itoa(isoDay), nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - ISOWeek
+// ----------------------------------------------------------------------------
+
+// Year returns the ISO week-year.
+func (isoWeek ISOWeek) Year() Year {
+	return isoWeek.year
+}
+
+// Week returns the number of the week in the week-year.
+func (isoWeek ISOWeek) Week() int {
+	return isoWeek.week
+}
+
+// Date returns the date of the ISO weekday in the week, 1 for Monday through
+// 7 for Sunday.
+func (isoWeek ISOWeek) Date(isoDay int) (Date, error) {
+	assert.Precondition(isISOWeek(isoWeek.year, isoWeek.week))
+	if isoDay < 1 || isoDay > 7 {
+		return Date{}, errors.New("ISO weekday must be between 1 and 7, not " + strconv.Itoa(isoDay))
+	}
+	//
+	// Week 1 is the week containing 4-Jan.
+	//
+	var jan4, err = New(1, 4, isoWeek.year)
+	if err != nil {
+		return Date{}, err
+	}
+	var weekDay DayOfWeek
+	weekDay, err = jan4.WeekDay()
+	if err != nil {
+		return Date{}, err
+	}
+	var offset = (isoWeek.week-1)*7 + isoDay - isoWeekDay(weekDay)
+	return Add(jan4, offset)
+}
+
+// First returns the Monday of the week.
+func (isoWeek ISOWeek) First() (Date, error) {
+	return isoWeek.Date(1)
+}
+
+// Last returns the Sunday of the week.
+func (isoWeek ISOWeek) Last() (Date, error) {
+	return isoWeek.Date(7)
+}
+
+// Next returns the following week.
+func (isoWeek ISOWeek) Next() (ISOWeek, error) {
+	if isoWeek.week < WeeksInISOYear(isoWeek.year) {
+		return NewISOWeek(isoWeek.year, isoWeek.week+1)
+	}
+	return NewISOWeek(isoWeek.year+1, 1)
+}
+
+// Prev returns the preceding week.
+func (isoWeek ISOWeek) Prev() (ISOWeek, error) {
+	if isoWeek.week > 1 {
+		return NewISOWeek(isoWeek.year, isoWeek.week-1)
+	}
+	var err = isYear(isoWeek.year - 1)
+	if err != nil {
+		return ISOWeek{}, err
+	}
+	return NewISOWeek(isoWeek.year-1, WeeksInISOYear(isoWeek.year-1))
+}
+
+// String returns the week in the ISO 8601 form YYYY-Www, for example 2024-W42.
+func (isoWeek ISOWeek) String() string {
+	var buffer = make([]byte, 0, 8)
+	buffer = appendInt(buffer, int(isoWeek.year), 4)
+	buffer = append(buffer, '-', 'W')
+	buffer = appendInt(buffer, isoWeek.week, 2)
+	return string(buffer)
+}
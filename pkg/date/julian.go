@@ -0,0 +1,205 @@
+// ----------------------------------------------------------------------------
+//
+// Julian
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements dates in the Julian calendar.  The Julian calendar has
+// the same months as the Gregorian calendar, but every year divisible by 4 is
+// a leap year.  Julian dates are converted to and from Gregorian dates
+// through the day number, which is shared by both calendars.  Years are
+// numbered as in ISO 8601, so year 0 is 1 BC.  Julian dates cover the years
+// of the PROLEPTIC mode, and their Gregorian dates are created in that mode.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// JulianDate represents a date in the Julian calendar.
+type JulianDate struct {
+	month Month
+	day   Day
+	year  Year
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// julianOffset is the number of days by which 1-Jan-1601 in the Julian
+// calendar follows 1-Jan-1601 in the Gregorian calendar.
+const julianOffset = 10
+
+// daysInJulian4YearCycle is the number of days in four Julian years.
+const daysInJulian4YearCycle = 365*4 + 1
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// IsJulianLeapYear returns true if the year is a leap year in the Julian
+// calendar.
+func IsJulianLeapYear(year Year) bool {
+	return floorMod(int(year), 4) == 0
+}
+
+// JulianDaysInMonth returns the number of days in the month and year of the
+// Julian calendar.
+func JulianDaysInMonth(month Month, year Year) (int, error) {
+	var err = isMonth(month)
+	if err != nil {
+		return 0, err
+	}
+	err = PROLEPTIC.isYear(year)
+	if err != nil {
+		return 0, err
+	}
+	var days = daysInMonth[month-1]
+	if month == 2 && IsJulianLeapYear(year) {
+		days++
+	}
+	return days, nil
+}
+
+// IsJulianDate returns nil if the month, day, and year are a valid date in
+// the Julian calendar.  Otherwise, an error is returned.
+func IsJulianDate(month Month, day Day, year Year) error {
+	var maxDays, err = JulianDaysInMonth(month, year)
+	switch {
+	case err != nil:
+		break
+	case day < 1:
+		err = errors.New("Day cannot be less than 1: " + strconv.Itoa(int(day)))
+	case int(day) > maxDays:
+		err = errors.New("Day cannot be greater than the number of days in the Julian month: " +
+			strconv.Itoa(int(day)))
+	}
+	return err
+}
+
+// NewJulian returns the Julian date with the month, day, and year.
+func NewJulian(month Month, day Day, year Year) (JulianDate, error) {
+	var err = IsJulianDate(month, day, year)
+	if err != nil {
+		return JulianDate{}, err
+	}
+	var julianDate = JulianDate{
+		month: month,
+		day:   day,
+		year:  year,
+	}
+	return julianDate, nil
+}
+
+// JulianFromDate returns the Julian date of the same day as the Gregorian
+// date.
+func JulianFromDate(date Date) (JulianDate, error) {
+	assert.Precondition(IsADate(date))
+	return JulianFromDayNumber(date.DayNumber())
+}
+
+// JulianFromDayNumber returns the Julian date with the day number.  An error
+// is returned if the year of the Julian date is outside the range of years.
+func JulianFromDayNumber(dayNumber int) (JulianDate, error) {
+	var year = Year(1601 + floorDiv(4*(dayNumber-julianOffset-1)+3, daysInJulian4YearCycle))
+	var err = PROLEPTIC.isYear(year)
+	if err != nil {
+		return JulianDate{}, errors.New("date.JulianFromDayNumber: day number " + strconv.Itoa(dayNumber) +
+			" is outside the range of Julian dates: " + err.Error())
+	}
+	var remaining = dayNumber - julianDayNumber(1, 1, year) + 1
+	var month = Month(1)
+	var days, _ = JulianDaysInMonth(month, year)
+	for remaining > days {
+		remaining -= days
+		month++
+		days, _ = JulianDaysInMonth(month, year)
+	}
+	return NewJulian(month, Day(remaining), year)
+}
+
+// julianDayNumber returns the day number of a valid Julian date.
+func julianDayNumber(month Month, day Day, year Year) int {
+	var y = int(year) - 1601
+	var pastDays = 365*y + floorDiv(y, 4)
+	var priorDays = 0
+	for m := Month(1); m < month; m++ {
+		var days, _ = JulianDaysInMonth(m, year)
+		priorDays += days
+	}
+	return pastDays + priorDays + int(day) + julianOffset
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Month returns the month of the Julian date.
+func (julianDate JulianDate) Month() Month {
+	return julianDate.month
+}
+
+// Day returns the day of the month of the Julian date.
+func (julianDate JulianDate) Day() Day {
+	return julianDate.day
+}
+
+// Year returns the year of the Julian date.
+func (julianDate JulianDate) Year() Year {
+	return julianDate.year
+}
+
+// DayNumber returns the day number of the Julian date, which is the day
+// number of the Gregorian date of the same day.
+func (julianDate JulianDate) DayNumber() int {
+	assert.Precondition(IsJulianDate(julianDate.month, julianDate.day, julianDate.year))
+	return julianDayNumber(julianDate.month, julianDate.day, julianDate.year)
+}
+
+// Date returns the Gregorian date of the same day as the Julian date.  An
+// error is returned if the Gregorian date is outside the range of the
+// PROLEPTIC mode.
+func (julianDate JulianDate) Date() (Date, error) {
+	var date, err = PROLEPTIC.FromDayNumber(julianDate.DayNumber())
+	if err != nil {
+		return Date{}, errors.New("JulianDate.Date: Julian date " + julianDate.String() +
+			" is outside the range of Gregorian dates")
+	}
+	return date, nil
+}
+
+// Before returns true if the Julian date is before the other Julian date.
+func (julianDate JulianDate) Before(anotherDate JulianDate) bool {
+	return julianDate.DayNumber() < anotherDate.DayNumber()
+}
+
+// String displays the Julian date in the format dd-MMM-yyyy followed by the
+// suffix O.S., for Old Style, for example 04-Oct-1582 O.S.
+func (julianDate JulianDate) String() string {
+	var buffer = make([]byte, 0, 18)
+	buffer = appendInt(buffer, int(julianDate.day), 2)
+	buffer = append(buffer, '-')
+	buffer = append(buffer, MonthName(julianDate.month)...)
+	buffer = append(buffer, '-')
+	buffer = appendInt(buffer, int(julianDate.year), 4)
+	buffer = append(buffer, " O.S."...)
+	return string(buffer)
+}
@@ -0,0 +1,166 @@
+// ----------------------------------------------------------------------------
+//
+// Mode
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the modes that select the range of dates a function
+// may create.  The package-level functions, such as New, Add, Parse, and
+// FromDayNumber, use STANDARD, the range 1-Jan-1601 through 31-Dec-3999.
+// The methods of PROLEPTIC extend the range to 1-Jan--9999 through
+// 31-Dec-9999 in the proleptic Gregorian calendar, in which the Gregorian
+// rules are applied to dates before the calendar was adopted, for example
+// PROLEPTIC.New(3, 15, -44).  Years are numbered as in ISO 8601, so year 0
+// is 1 BC and year -1 is 2 BC.
+//
+// A date created with PROLEPTIC is a valid date.  It can be compared,
+// formatted, and converted, and its day number is zero or less before
+// 1-Jan-1601.  ISO weeks are limited to the standard range, so ISOWeek and
+// ISOWeekString return an error for a date outside it.  The functions that compute another date from a date, such as
+// Increment and AddMonths, return an error if the result is outside the
+// standard range.  Use PROLEPTIC.Add for arithmetic in the proleptic range.
+// The boundaries of the week, month, quarter, and year containing a date,
+// such as StartOfWeek, are in the range of the mode of the date.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Mode selects the range of dates that a function may create.
+type Mode int
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// STANDARD is the range MinDate through MaxDate.
+	STANDARD Mode = 0
+	// PROLEPTIC is the range ProlepticMinDate through ProlepticMaxDate.
+	PROLEPTIC Mode = 1
+)
+
+const (
+	ProlepticMaxYear = 9999
+	ProlepticMinYear = -9999
+)
+
+// ProlepticMinDayNumber and ProlepticMaxDayNumber are the day numbers of
+// ProlepticMinDate and ProlepticMaxDate.
+const (
+	ProlepticMinDayNumber = -4236812
+	ProlepticMaxDayNumber = 3067671
+)
+
+var ProlepticMaxDate = Date{month: 12, day: 31, year: ProlepticMaxYear}
+var ProlepticMinDate = Date{month: 1, day: 1, year: ProlepticMinYear}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// isMode returns an error if the mode is not valid.
+func isMode(mode Mode) error {
+	var err error = nil
+	if mode != STANDARD && mode != PROLEPTIC {
+		err = errors.New("mode must be STANDARD or PROLEPTIC, not " + strconv.Itoa(int(mode)))
+	}
+	return err
+}
+
+// modeOf returns STANDARD if the date is in the range of the STANDARD mode
+// and PROLEPTIC otherwise.
+func modeOf(date Date) Mode {
+	if STANDARD.Contains(date) {
+		return STANDARD
+	}
+	return PROLEPTIC
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// MinYear returns the earliest year of the mode.
+func (mode Mode) MinYear() Year {
+	assert.Precondition(isMode(mode))
+	if mode == PROLEPTIC {
+		return ProlepticMinYear
+	}
+	return MinYear
+}
+
+// MaxYear returns the latest year of the mode.
+func (mode Mode) MaxYear() Year {
+	assert.Precondition(isMode(mode))
+	if mode == PROLEPTIC {
+		return ProlepticMaxYear
+	}
+	return MaxYear
+}
+
+// MinDate returns the earliest date of the mode.
+func (mode Mode) MinDate() Date {
+	return Date{month: 1, day: 1, year: mode.MinYear()}
+}
+
+// MaxDate returns the latest date of the mode.
+func (mode Mode) MaxDate() Date {
+	return Date{month: 12, day: 31, year: mode.MaxYear()}
+}
+
+// MinDayNumber returns the day number of the earliest date of the mode.
+func (mode Mode) MinDayNumber() int {
+	assert.Precondition(isMode(mode))
+	if mode == PROLEPTIC {
+		return ProlepticMinDayNumber
+	}
+	return MinDayNumber
+}
+
+// MaxDayNumber returns the day number of the latest date of the mode.
+func (mode Mode) MaxDayNumber() int {
+	assert.Precondition(isMode(mode))
+	if mode == PROLEPTIC {
+		return ProlepticMaxDayNumber
+	}
+	return MaxDayNumber
+}
+
+// Contains returns true if the date is a valid date in the range of the
+// mode.
+func (mode Mode) Contains(date Date) bool {
+	return mode.IsDate(date.month, date.day, date.year) == nil
+}
+
+// String returns the name of the mode.
+func (mode Mode) String() string {
+	var name string
+	switch mode {
+	case STANDARD:
+		name = "STANDARD"
+	case PROLEPTIC:
+		name = "PROLEPTIC"
+	default:
+		name = "Mode(" + strconv.Itoa(int(mode)) + ")"
+	}
+	return name
+}
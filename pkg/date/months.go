@@ -0,0 +1,170 @@
+// ----------------------------------------------------------------------------
+//
+// Months
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the addition of months and years to dates.  Adding
+// months keeps the day of the month unless the target month is too short,
+// for example one month after 31-Jan.  The MonthPolicy specifies what happens
+// then and whether month ends are kept as month ends.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// InvalidDay specifies how to handle a day that does not exist in the target
+// month.
+type InvalidDay int
+
+// MonthPolicy specifies how months are added to a date.  The zero value
+// clamps invalid days to the end of the month and does not keep month ends.
+type MonthPolicy struct {
+	// InvalidDay specifies how to handle a day that does not exist in the
+	// target month.
+	InvalidDay InvalidDay
+	// StickyEndOfMonth specifies that the last day of a month is moved to the
+	// last day of the target month, so 28-Feb-2023 + 1 month = 31-Mar-2023.
+	StickyEndOfMonth bool
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// CLAMP moves an invalid day to the last day of the target month, so
+	// 31-Jan-2024 + 1 month = 29-Feb-2024.
+	CLAMP InvalidDay = 0
+	// OVERFLOW carries the extra days into the next month, so
+	// 31-Jan-2024 + 1 month = 2-Mar-2024.
+	OVERFLOW InvalidDay = 1
+	// REJECT returns an error for an invalid day.
+	REJECT InvalidDay = 2
+)
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// isInvalidDay returns an error if the value is not a valid InvalidDay.
+func isInvalidDay(invalidDay InvalidDay) error {
+	var err error = nil
+	if invalidDay < CLAMP || invalidDay > REJECT {
+		err = errors.New("invalid day policy must be CLAMP, OVERFLOW, or REJECT, not " +
+			strconv.Itoa(int(invalidDay)))
+	}
+	return err
+}
+
+// IsEndOfMonth returns true if the date is the last day of its month.
+func IsEndOfMonth(date Date) bool {
+	assert.Precondition(IsADate(date))
+	var lastDay, _ = PROLEPTIC.DaysInMonth(date.month, date.year)
+	return int(date.day) == lastDay
+}
+
+// AddMonths adds the number of months to the date if num > 0.  AddMonths
+// subtracts the number of months from the date if num < 0.  The policy
+// specifies how to handle a day that does not exist in the target month.
+// An error is returned if the result is outside the range of dates.
+func AddMonths(date Date, num int, policy MonthPolicy) (Date, error) {
+	return STANDARD.AddMonths(date, num, policy)
+}
+
+// AddMonths adds the number of months to the date as in AddMonths.  An error
+// is returned if the result is outside the range of the mode.
+func (mode Mode) AddMonths(date Date, num int, policy MonthPolicy) (Date, error) {
+	var err error
+	var lastDay int
+	var result Date
+
+	assert.Precondition(IsADate(date))
+	err = isInvalidDay(policy.InvalidDay)
+	if err != nil {
+		return date, err
+	}
+	//
+	// Determine the target month
+	//
+	var months = int(date.year)*12 + int(date.month) - 1 + num
+	var year = Year(floorDiv(months, 12))
+	var month = Month(months - int(year)*12 + 1)
+	err = mode.isYear(year)
+	if err != nil {
+		var message = "value " + strconv.Itoa(num) + " months is out of range for date " +
+			date.String() + ": " + err.Error()
+		return date, errors.New(message)
+	}
+	lastDay, _ = mode.DaysInMonth(month, year)
+	//
+	// Determine the day
+	//
+	switch {
+	case policy.StickyEndOfMonth && IsEndOfMonth(date):
+		result, err = mode.New(month, Day(lastDay), year)
+	case int(date.day) <= lastDay:
+		result, err = mode.New(month, date.day, year)
+	case policy.InvalidDay == CLAMP:
+		result, err = mode.New(month, Day(lastDay), year)
+	case policy.InvalidDay == OVERFLOW:
+		result, err = mode.New(month, Day(lastDay), year)
+		if err == nil {
+			result, err = mode.Add(result, int(date.day)-lastDay)
+		}
+	default:
+		var message = "day " + strconv.Itoa(int(date.day)) + " does not exist in " +
+			MonthName(month) + "-" + strconv.Itoa(int(year))
+		err = errors.New(message)
+	}
+	if err != nil {
+		return date, err
+	}
+	// Postcondition:
+	//   err != nil or
+	//   result is in the month num months after date.month, except with OVERFLOW
+	return result, nil
+}
+
+// AddYears adds the number of years to the date if num > 0.  AddYears
+// subtracts the number of years from the date if num < 0.  The policy
+// specifies how to handle 29-Feb in a year that is not a leap year.
+func AddYears(date Date, num int, policy MonthPolicy) (Date, error) {
+	return AddMonths(date, num*12, policy)
+}
+
+// floorDiv returns the quotient of a and b rounded toward negative infinity.
+//
+// Precondition: b > 0
+func floorDiv(a int, b int) int {
+	var quotient = a / b
+	if a%b < 0 {
+		quotient--
+	}
+	return quotient
+}
+
+// floorMod returns the remainder of a divided by b, which has the sign of b.
+//
+// Precondition: b > 0
+func floorMod(a int, b int) int {
+	return a - b*floorDiv(a, b)
+}
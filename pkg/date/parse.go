@@ -0,0 +1,376 @@
+// ----------------------------------------------------------------------------
+//
+// Parse
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the parsing of dates with layouts.  The layouts are
+// the same as those used by Format.  When parsing:
+//
+//	-- A single pattern letter for a number (d, M, y, D) accepts a variable
+//	   number of digits.  Two or more letters require exactly that many digits.
+//	-- A two-digit year (yy) is in 1969 through 2068.
+//	-- Any other year may begin with a plus or minus sign, as in the ISO 8601
+//	   expanded representation, for example -0044-03-15.  Years before 1601
+//	   are accepted only by PROLEPTIC.Parse.
+//	-- Month and weekday names are matched without regard to case.
+//	-- A weekday (EEE, EEEE) must agree with the date.
+//	-- The layout must specify the year and either the month and day or the
+//	   day of the year.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ParseError describes a failure to parse a date string with a layout.
+type ParseError struct {
+	Layout string // the layout used for parsing
+	Value  string // the string being parsed
+	Field  string // the field that failed: year, month, day, day of year, weekday, or literal
+	Reason string // the reason the field failed
+}
+
+// parsedFields holds the components of a date found while parsing.
+type parsedFields struct {
+	year      int
+	month     int
+	day       int
+	dayOfYear int
+	weekDay   int
+	hasYear   bool
+	hasMonth  bool
+	hasDay    bool
+	hasDOY    bool
+	hasWeek   bool
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// DefaultLayouts are the layouts tried by ParseAny when no layouts are
+// specified.  Each layout uses a different separator, so a string matches at
+// most one of them: 04/10/2024 is April 10 by USSlash and 04.10.2024 is
+// 4 October by EUDot.  When layouts passed to ParseAny share a separator,
+// the first layout that succeeds wins, so with MM/dd/yyyy before dd/MM/yyyy,
+// 04/10/2024 is April 10.
+var DefaultLayouts = []string{
+	ISO8601,
+	ISO8601Basic,
+	DateBench,
+	EUDot,
+	USSlash,
+}
+
+// Field names reported in a ParseError.
+const (
+	FieldYear      = "year"
+	FieldMonth     = "month"
+	FieldDay       = "day"
+	FieldDayOfYear = "day of year"
+	FieldWeekDay   = "weekday"
+	FieldLiteral   = "literal"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - ParseError
+// ----------------------------------------------------------------------------
+
+// Error returns a description of the parse error.
+func (parseError *ParseError) Error() string {
+	var message = "date.Parse: cannot parse " + strconv.Quote(parseError.Value) +
+		" with layout " + strconv.Quote(parseError.Layout) + ": invalid " +
+		parseError.Field + ": " + parseError.Reason
+	return message
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// Parse converts a string into a date using the layout.  If the string does
+// not match the layout or does not represent a valid date, the error is a
+// *ParseError identifying the field that failed.
+func Parse(layout string, value string) (Date, error) {
+	return STANDARD.Parse(layout, value)
+}
+
+// Parse converts a string into a date in the range of the mode using the
+// layout, as in the function Parse.
+func (mode Mode) Parse(layout string, value string) (Date, error) {
+	var fields parsedFields
+	var position = 0
+	var index = 0
+
+	var fail = func(field string, reason string) (Date, error) {
+		var err = &ParseError{
+			Layout: layout,
+			Value:  value,
+			Field:  field,
+			Reason: reason,
+		}
+		return Date{}, err
+	}
+
+	for index < len(layout) {
+		var ch = layout[index]
+		//
+		// Quoted literal text
+		//
+		if ch == '\'' {
+			var literal strings.Builder
+			var end = index + 1
+			if end < len(layout) && layout[end] == '\'' {
+				literal.WriteByte('\'')
+				end++
+			} else {
+				for end < len(layout) {
+					if layout[end] == '\'' {
+						if end+1 < len(layout) && layout[end+1] == '\'' {
+							literal.WriteByte('\'')
+							end += 2
+							continue
+						}
+						end++
+						break
+					}
+					literal.WriteByte(layout[end])
+					end++
+				}
+			}
+			var text = literal.String()
+			if !strings.HasPrefix(value[position:], text) {
+				return fail(FieldLiteral, "expected "+strconv.Quote(text)+" at position "+strconv.Itoa(position))
+			}
+			position += len(text)
+			index = end
+			continue
+		}
+		//
+		// Literal character
+		//
+		if !isPatternLetter(ch) {
+			if position >= len(value) || value[position] != ch {
+				return fail(FieldLiteral, "expected "+strconv.Quote(string(ch))+" at position "+strconv.Itoa(position))
+			}
+			position++
+			index++
+			continue
+		}
+		//
+		// Pattern
+		//
+		var count = 1
+		for index+count < len(layout) && layout[index+count] == ch {
+			count++
+		}
+		index += count
+
+		var err error
+		var field string
+		switch {
+		case ch == 'd':
+			field = FieldDay
+			fields.day, position, err = parseNumber(value, position, count, 2)
+			fields.hasDay = true
+		case ch == 'M' && count <= 2:
+			field = FieldMonth
+			fields.month, position, err = parseNumber(value, position, count, 2)
+			fields.hasMonth = true
+		case ch == 'M':
+			field = FieldMonth
+			var names = namesMonth
+			if count > 3 {
+				names = namesMonthFull
+			}
+			fields.month, position, err = parseName(value, position, names)
+			fields.month++
+			fields.hasMonth = true
+		case ch == 'y':
+			field = FieldYear
+			fields.year, position, err = parseYear(value, position, count)
+			if err == nil && count == 2 {
+				fields.year = pivotYear(fields.year)
+			}
+			fields.hasYear = true
+		case ch == 'D':
+			field = FieldDayOfYear
+			fields.dayOfYear, position, err = parseNumber(value, position, count, 3)
+			fields.hasDOY = true
+		case ch == 'E':
+			field = FieldWeekDay
+			var names = weekDayAbbreviations()
+			if count > 3 {
+				names = namesWeekDay
+			}
+			fields.weekDay, position, err = parseName(value, position, names)
+			fields.hasWeek = true
+		}
+		if err != nil {
+			return fail(field, err.Error())
+		}
+	}
+	if position < len(value) {
+		return fail(FieldLiteral, "unexpected text "+strconv.Quote(value[position:])+" at end of value")
+	}
+	return fields.toDate(mode, fail)
+}
+
+// ParseAny converts a string into a date by trying each layout in turn and
+// returning the date from the first layout that succeeds.  If no layouts are
+// specified, DefaultLayouts is used.  If every layout fails, the error joins
+// the *ParseError from each layout, which can be examined with errors.As.
+func ParseAny(value string, layouts ...string) (Date, error) {
+	if len(layouts) == 0 {
+		layouts = DefaultLayouts
+	}
+	var errs = []error{
+		errors.New("date.ParseAny: " + strconv.Quote(value) + " does not match any layout"),
+	}
+	var trimmed = strings.TrimSpace(value)
+	for _, layout := range layouts {
+		var date, err = Parse(layout, trimmed)
+		if err == nil {
+			return date, nil
+		}
+		errs = append(errs, err)
+	}
+	return Date{}, errors.Join(errs...)
+}
+
+// parseNumber reads an unsigned decimal number starting at position.  If
+// count is 1, it reads 1 to maxDigits digits.  Otherwise, it reads exactly
+// count digits.  It returns the number and the position after the digits.
+func parseNumber(value string, position int, count int, maxDigits int) (int, int, error) {
+	var minDigits = count
+	if count == 1 {
+		minDigits = 1
+	} else {
+		maxDigits = count
+	}
+	var end = position
+	for end < len(value) && end-position < maxDigits && value[end] >= '0' && value[end] <= '9' {
+		end++
+	}
+	if end-position < minDigits {
+		var err = errors.New("expected " + strconv.Itoa(minDigits) + " digits at position " +
+			strconv.Itoa(position))
+		return 0, position, err
+	}
+	var number, _ = strconv.Atoi(value[position:end])
+	return number, end, nil
+}
+
+// parseYear reads a year starting at position in the manner of parseNumber.
+// Unless count is 2, the year may begin with a plus or minus sign.
+func parseYear(value string, position int, count int) (int, int, error) {
+	var sign = 1
+	if count != 2 && position < len(value) && (value[position] == '-' || value[position] == '+') {
+		if value[position] == '-' {
+			sign = -1
+		}
+		position++
+	}
+	var year, end, err = parseNumber(value, position, count, 4)
+	return sign * year, end, err
+}
+
+// parseName reads one of the names starting at position, without regard to
+// case.  It returns the index of the name and the position after the name.
+func parseName(value string, position int, names []string) (int, int, error) {
+	for index, name := range names {
+		var end = position + len(name)
+		if end <= len(value) && strings.EqualFold(value[position:end], name) {
+			return index, end, nil
+		}
+	}
+	var err = errors.New("unrecognized name at position " + strconv.Itoa(position))
+	return 0, position, err
+}
+
+// weekDayAbbreviations returns the three-letter abbreviations of the days of
+// the week.
+func weekDayAbbreviations() []string {
+	var names = make([]string, len(namesWeekDay))
+	for index, name := range namesWeekDay {
+		names[index] = name[0:3]
+	}
+	return names
+}
+
+// pivotYear converts a two-digit year into a year in 1969 through 2068.
+func pivotYear(year int) int {
+	if year < 69 {
+		return 2000 + year
+	}
+	return 1900 + year
+}
+
+// toDate validates the parsed fields and creates the date in the range of
+// the mode.
+func (fields parsedFields) toDate(mode Mode, fail func(string, string) (Date, error)) (Date, error) {
+	var err error
+	var date Date
+
+	if !fields.hasYear {
+		return fail(FieldYear, "layout does not contain a year")
+	}
+	err = mode.isYear(Year(fields.year))
+	if err != nil {
+		return fail(FieldYear, err.Error())
+	}
+	switch {
+	case fields.hasMonth && fields.hasDay:
+		err = isMonth(Month(fields.month))
+		if err != nil {
+			return fail(FieldMonth, err.Error())
+		}
+		err = isDay(Month(fields.month), Day(fields.day), Year(fields.year))
+		if err != nil {
+			return fail(FieldDay, err.Error())
+		}
+		date, err = mode.New(Month(fields.month), Day(fields.day), Year(fields.year))
+		if err == nil && fields.hasDOY && DayYear(date) != DayOfYear(fields.dayOfYear) {
+			return fail(FieldDayOfYear, "day of year "+strconv.Itoa(fields.dayOfYear)+
+				" does not agree with date "+date.String())
+		}
+	case fields.hasDOY:
+		err = isDayOfYear(DayOfYear(fields.dayOfYear), Year(fields.year))
+		if err != nil {
+			return fail(FieldDayOfYear, err.Error())
+		}
+		date, err = mode.FromDayOfYear(DayOfYear(fields.dayOfYear), Year(fields.year))
+	case !fields.hasMonth:
+		return fail(FieldMonth, "layout does not contain a month or day of year")
+	default:
+		return fail(FieldDay, "layout does not contain a day or day of year")
+	}
+	if err != nil {
+		return Date{}, err
+	}
+	if fields.hasWeek {
+		var weekDay, _ = date.WeekDay()
+		if int(weekDay) != fields.weekDay {
+			return fail(FieldWeekDay, namesWeekDay[fields.weekDay]+" does not agree with date "+
+				date.String()+", which is a "+namesWeekDay[weekDay])
+		}
+	}
+	return date, nil
+}
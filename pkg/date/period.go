@@ -0,0 +1,243 @@
+// ----------------------------------------------------------------------------
+//
+// Period
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements periods of years, months, and days.  A period is
+// added to a date by first adding the years and months with AddMonths using
+// the CLAMP policy, and then adding the days with Add.  Between computes the
+// period from one date to another so that AddPeriod(date1, Between(date1,
+// date2)) = date2.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Period is an amount of time in years, months, and days, such as 2 years,
+// 3 months, and 12 days.  The components may be negative.
+type Period struct {
+	years  int
+	months int
+	days   int
+}
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewPeriod returns a period with the specified years, months, and days.
+// The period is not normalized.
+func NewPeriod(years int, months int, days int) Period {
+	var period = Period{
+		years:  years,
+		months: months,
+		days:   days,
+	}
+	return period
+}
+
+// Between returns the period from date1 to date2.  The period is positive
+// if date2 is after date1 and negative if date2 is before date1.  The years
+// and months are normalized, so the absolute value of the months is less
+// than 12, and all components have the same sign.  For example, the period
+// from 31-Jan-2024 to 1-Mar-2024 is 1 month and 1 day, because one month
+// after 31-Jan-2024 is 29-Feb-2024.  The dates may be in either mode.
+func Between(date1 Date, date2 Date) Period {
+	assert.Precondition(IsADate(date1))
+	assert.Precondition(IsADate(date2))
+
+	var policy = MonthPolicy{}
+	var totalMonths = (int(date2.year)*12 + int(date2.month)) - (int(date1.year)*12 + int(date1.month))
+	var candidate, err = PROLEPTIC.AddMonths(date1, totalMonths, policy)
+	//
+	// Step back one month if the months overshoot date2.  The candidate can
+	// overshoot only by the difference of days within the final month.
+	//
+	switch {
+	case err != nil:
+		break
+	case totalMonths > 0 && candidate.After(date2):
+		totalMonths--
+		candidate, err = PROLEPTIC.AddMonths(date1, totalMonths, policy)
+	case totalMonths < 0 && candidate.Before(date2):
+		totalMonths++
+		candidate, err = PROLEPTIC.AddMonths(date1, totalMonths, policy)
+	}
+	assert.Assert(err == nil, "Between: unexpected error adding months")
+	var days = Difference(date2, candidate)
+	var period = NewPeriod(0, totalMonths, days).Normalized()
+	// Postcondition:
+	//   date1 and date2 are not in the STANDARD range or
+	//   AddPeriod(date1, period) = date2
+	return period
+}
+
+// AddPeriod adds the period to the date.  The years and months are added
+// first using AddMonths with the CLAMP policy, and then the days are added.
+// An error is returned if the result is outside the range of dates.
+func AddPeriod(date Date, period Period) (Date, error) {
+	var result, err = AddMonths(date, period.TotalMonths(), MonthPolicy{})
+	if err != nil {
+		return date, err
+	}
+	result, err = Add(result, period.days)
+	if err != nil {
+		return date, err
+	}
+	return result, nil
+}
+
+// ParsePeriod converts an ISO 8601 duration with years, months, weeks, and
+// days, such as P2Y3M12D, into a period.  A leading minus sign negates the
+// whole period, and individual components may be negative, as in P-1M.
+// Weeks are converted to 7 days.  Time components (after T) are not
+// supported.
+func ParsePeriod(value string) (Period, error) {
+	var fail = func(reason string) (Period, error) {
+		return Period{}, errors.New("date.ParsePeriod: cannot parse " + strconv.Quote(value) + ": " + reason)
+	}
+	var period Period
+	var text = value
+	var negate = false
+
+	if strings.HasPrefix(text, "-") {
+		negate = true
+		text = text[1:]
+	} else if strings.HasPrefix(text, "+") {
+		text = text[1:]
+	}
+	if len(text) < 3 || (text[0] != 'P' && text[0] != 'p') {
+		return fail("period must start with P and have at least one component")
+	}
+	text = text[1:]
+
+	var order = "YMWD"
+	var last = -1
+	for text != "" {
+		if text[0] == 'T' || text[0] == 't' {
+			return fail("time components are not supported")
+		}
+		var end = 0
+		if text[end] == '-' || text[end] == '+' {
+			end++
+		}
+		for end < len(text) && text[end] >= '0' && text[end] <= '9' {
+			end++
+		}
+		if end == len(text) {
+			return fail("missing unit after " + strconv.Quote(text))
+		}
+		var number, err = strconv.Atoi(text[0:end])
+		if err != nil {
+			return fail("invalid number " + strconv.Quote(text[0:end]))
+		}
+		var unit = strings.ToUpper(text[end : end+1])
+		var position = strings.Index(order, unit)
+		switch {
+		case position < 0:
+			return fail("unknown unit " + strconv.Quote(unit))
+		case position <= last:
+			return fail("unit " + unit + " is out of order or repeated")
+		}
+		last = position
+		switch unit {
+		case "Y":
+			period.years = number
+		case "M":
+			period.months = number
+		case "W":
+			period.days += 7 * number
+		case "D":
+			period.days += number
+		}
+		text = text[end+1:]
+	}
+	if negate {
+		period = period.Negated()
+	}
+	return period, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Years returns the years in the period.
+func (period Period) Years() int {
+	return period.years
+}
+
+// Months returns the months in the period.
+func (period Period) Months() int {
+	return period.months
+}
+
+// Days returns the days in the period.
+func (period Period) Days() int {
+	return period.days
+}
+
+// TotalMonths returns the years and months in the period as months.
+func (period Period) TotalMonths() int {
+	return period.years*12 + period.months
+}
+
+// IsZero returns true if all components of the period are zero.
+func (period Period) IsZero() bool {
+	return period == Period{}
+}
+
+// Negated returns the period with each component negated.
+func (period Period) Negated() Period {
+	return NewPeriod(-period.years, -period.months, -period.days)
+}
+
+// Normalized returns the period with the years and months adjusted so the
+// absolute value of the months is less than 12 and the years and months have
+// the same sign.  The days are unchanged.
+func (period Period) Normalized() Period {
+	var totalMonths = period.TotalMonths()
+	return NewPeriod(totalMonths/12, totalMonths%12, period.days)
+}
+
+// String returns the period as an ISO 8601 duration, such as P2Y3M12D.
+// Zero components are omitted, and the zero period is P0D.
+func (period Period) String() string {
+	if period.IsZero() {
+		return "P0D"
+	}
+	var buffer = []byte{'P'}
+	if period.years != 0 {
+		buffer = strconv.AppendInt(buffer, int64(period.years), 10)
+		buffer = append(buffer, 'Y')
+	}
+	if period.months != 0 {
+		buffer = strconv.AppendInt(buffer, int64(period.months), 10)
+		buffer = append(buffer, 'M')
+	}
+	if period.days != 0 {
+		buffer = strconv.AppendInt(buffer, int64(period.days), 10)
+		buffer = append(buffer, 'D')
+	}
+	return string(buffer)
+}
@@ -0,0 +1,216 @@
+// ----------------------------------------------------------------------------
+//
+// Quarter
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file manages calendar quarter structures.  Quarter 1 is January
+// through March, and quarter 4 is October through December.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Quarter is a calendar quarter of a year.
+//
+//	1 <= Number <= 4
+type Quarter struct {
+	Year   int
+	Number int
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const MonthsInQuarter = 3
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewQuarter returns the quarter with the specified year and number.
+func NewQuarter(year int, number int) (Quarter, error) {
+	var err error = nil
+	//
+	// Preconditions
+	//
+	err = isYear(Year(year))
+	if err != nil {
+		return Quarter{}, err
+	}
+	err = isQuarter(number)
+	if err != nil {
+		return Quarter{}, err
+	}
+	//
+	// Set the values
+	//
+	quarter := Quarter{
+		Year:   year,
+		Number: number,
+	}
+	return quarter, err
+}
+
+// NewQuarterFromDate returns the quarter containing the date.
+func NewQuarterFromDate(date Date) (Quarter, error) {
+	var year = int(date.Year())
+	var number = (int(date.Month())-1)/MonthsInQuarter + 1
+	return NewQuarter(year, number)
+}
+
+// NewQuarterFromYearMonth returns the quarter containing the year month.
+func NewQuarterFromYearMonth(yearMonth YearMonth) (Quarter, error) {
+	var err = isMonth(Month(yearMonth.Month))
+	if err != nil {
+		return Quarter{}, err
+	}
+	var number = (yearMonth.Month-1)/MonthsInQuarter + 1
+	return NewQuarter(yearMonth.Year, number)
+}
+
+// ParseQuarter converts a string in the form YYYY-Qn, for example 2024-Q3,
+// into a quarter.
+func ParseQuarter(value string) (Quarter, error) {
+	var fail = func(reason string) (Quarter, error) {
+		return Quarter{}, errors.New("date.ParseQuarter: cannot parse " + strconv.Quote(value) + ": " + reason)
+	}
+	var year, position, err = parseNumber(value, 0, 4, 4)
+	if err != nil {
+		return fail("invalid year: " + err.Error())
+	}
+	if len(value) != position+3 || value[position] != '-' || (value[position+1] != 'Q' && value[position+1] != 'q') {
+		return fail("quarter must be in the form YYYY-Qn")
+	}
+	var number = int(value[position+2]) - '0'
+	var quarter Quarter
+	quarter, err = NewQuarter(year, number)
+	if err != nil {
+		return fail(err.Error())
+	}
+	return quarter, nil
+}
+
+// QuarterKeys returns a slice with the quarters from one quarter through
+// another, in the manner of Keys.
+func QuarterKeys(from Quarter, thru Quarter) ([]Quarter, error) {
+	var keys []Quarter
+	var err error
+	var quarter Quarter
+
+	for year := from.Year; year <= thru.Year; year++ {
+		for number := 1; number <= 4; number++ {
+			switch {
+			case year == from.Year && number < from.Number:
+				// do nothing
+			case year == thru.Year && number > thru.Number:
+				// do nothing
+			default:
+				quarter, err = NewQuarter(year, number)
+				if err != nil {
+					return keys, err
+				}
+				keys = append(keys, quarter)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// isQuarter returns an error if the number is not a valid quarter number.
+func isQuarter(number int) error {
+	var err error
+	switch {
+	case number < 1:
+		err = errors.New("Quarter cannot be less than 1: " + strconv.Itoa(number))
+	case number > 4:
+		err = errors.New("Quarter cannot be greater than 4: " + strconv.Itoa(number))
+	default:
+		err = nil
+	}
+	return err
+}
+
+// isAQuarter returns an error if the quarter does not have valid components.
+func isAQuarter(quarter Quarter) error {
+	var err = isYear(Year(quarter.Year))
+	if err == nil {
+		err = isQuarter(quarter.Number)
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Quarter
+// ----------------------------------------------------------------------------
+
+// FirstMonth returns the first month of the quarter.
+func (quarter Quarter) FirstMonth() Month {
+	return Month((quarter.Number-1)*MonthsInQuarter + 1)
+}
+
+// First returns the first date of the quarter.
+func (quarter Quarter) First() Date {
+	assert.Precondition(isAQuarter(quarter))
+	var date, _ = New(quarter.FirstMonth(), 1, Year(quarter.Year))
+	return date
+}
+
+// Last returns the last date of the quarter.
+func (quarter Quarter) Last() Date {
+	assert.Precondition(isAQuarter(quarter))
+	var month = quarter.FirstMonth() + MonthsInQuarter - 1
+	var lastDay, _ = DaysInMonth(month, Year(quarter.Year))
+	var date, _ = New(month, Day(lastDay), Year(quarter.Year))
+	return date
+}
+
+// Contains returns true if the date is in the quarter.
+func (quarter Quarter) Contains(date Date) bool {
+	var dateQuarter, err = NewQuarterFromDate(date)
+	return err == nil && dateQuarter == quarter
+}
+
+// Next returns the following quarter.
+func (quarter Quarter) Next() (Quarter, error) {
+	if quarter.Number == 4 {
+		return NewQuarter(quarter.Year+1, 1)
+	}
+	return NewQuarter(quarter.Year, quarter.Number+1)
+}
+
+// Prev returns the preceding quarter.
+func (quarter Quarter) Prev() (Quarter, error) {
+	if quarter.Number == 1 {
+		return NewQuarter(quarter.Year-1, 4)
+	}
+	return NewQuarter(quarter.Year, quarter.Number-1)
+}
+
+// String converts a quarter to a string in the format YYYY-Qn.
+func (quarter Quarter) String() string {
+	var buffer = make([]byte, 0, 8)
+	buffer = appendInt(buffer, quarter.Year, 4)
+	buffer = append(buffer, '-', 'Q')
+	buffer = appendInt(buffer, quarter.Number, 1)
+	return string(buffer)
+}
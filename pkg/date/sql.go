@@ -0,0 +1,158 @@
+// ----------------------------------------------------------------------------
+//
+// SQL
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the database/sql interfaces for dates.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// suffixBC marks the dates before 1 AD in Postgres, which numbers those
+// years 1 BC, 2 BC, and so on rather than 0, -1, and so on.
+const suffixBC = " BC"
+
+// infinity and minusInfinity are the special Postgres dates later and earlier
+// than all other dates.
+const (
+	infinity      = "infinity"
+	minusInfinity = "-infinity"
+)
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Scan implements the sql.Scanner interface.  The source may be a time.Time,
+// or a string or []byte holding a date in the ISO 8601 format YYYY-MM-DD.
+// A string may carry a time of day after the date, as SQLite drivers
+// return, for example "2024-01-01 00:00:00+00:00" or "2024-01-01T00:00:00Z".
+// The time of day is ignored.  A date before 1 AD may be written in the
+// Postgres form with the suffix BC, for example "0045-03-15 BC" for
+// -0044-03-15.  Since a database may hold dates outside MinDate through
+// MaxDate, the date is created in the PROLEPTIC mode.  The Postgres values
+// infinity and -infinity are converted to MaxDate and MinDate.  A NULL value
+// sets the date to the zero value.
+func (date *Date) Scan(src any) error {
+	var err error
+	var result Date
+
+	switch value := src.(type) {
+	case nil:
+		result = Date{}
+	case time.Time:
+		result, err = PROLEPTIC.FromTime(value)
+	case string:
+		result, err = scanString(value)
+	case []byte:
+		result, err = scanString(string(value))
+	default:
+		err = fmt.Errorf("cannot convert %T to a date", src)
+	}
+	if err != nil {
+		return errors.New("date.Scan: " + err.Error())
+	}
+	*date = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface.  A valid date is sent to the
+// database as a string in the ISO 8601 format YYYY-MM-DD.  A date before
+// 1 AD is sent in the Postgres form with the suffix BC.  Since Postgres
+// cannot store a date before 24-Nov-4714 BC, which is Julian Day 0, an error
+// is returned for an earlier date.  The zero value of Date is sent as NULL.
+func (date Date) Value() (driver.Value, error) {
+	if date == (Date{}) {
+		return nil, nil
+	}
+	var err = IsADate(date)
+	if err != nil {
+		return nil, errors.New("date.Value: " + err.Error())
+	}
+	if date.JulianDayNumber() < 0 {
+		return nil, errors.New("date.Value: date " + date.String() +
+			" is before 24-Nov-4714 BC, the earliest date Postgres can store")
+	}
+	return date.sqlString(), nil
+}
+
+// sqlString returns the date in the form Postgres uses for date columns.
+func (date Date) sqlString() string {
+	if date.year > 0 {
+		return date.isoString()
+	}
+	var buffer = make([]byte, 0, 16)
+	buffer = appendInt(buffer, 1-int(date.year), 4)
+	buffer = date.AppendFormat(buffer, "-MM-dd")
+	buffer = append(buffer, suffixBC...)
+	return string(buffer)
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// scanString converts a date string returned by a database driver into a
+// date in the PROLEPTIC mode.  Any time of day following the date is
+// discarded.
+func scanString(value string) (Date, error) {
+	switch value {
+	case infinity:
+		return MaxDate, nil
+	case minusInfinity:
+		return MinDate, nil
+	}
+	if strings.HasSuffix(value, suffixBC) {
+		return scanBC(value[0 : len(value)-len(suffixBC)])
+	}
+	if len(value) > 10 && (value[10] == ' ' || value[10] == 'T') {
+		value = value[0:10]
+	}
+	return PROLEPTIC.Parse(ISO8601, value)
+}
+
+// scanBC converts a Postgres date before 1 AD, with the suffix BC removed,
+// into a date.  The year has four or five digits.  The month and day are
+// checked in the leap year 2000 and then in the actual year.
+func scanBC(value string) (Date, error) {
+	var index = strings.IndexByte(value, '-')
+	if index < 4 {
+		return Date{}, errors.New("invalid BC date: " + strconv.Quote(value+suffixBC))
+	}
+	var year, err = strconv.Atoi(value[0:index])
+	if err != nil {
+		return Date{}, errors.New("invalid BC year: " + strconv.Quote(value+suffixBC))
+	}
+	var rest = value[index:]
+	if len(rest) > 6 && (rest[6] == ' ' || rest[6] == 'T') {
+		rest = rest[0:6]
+	}
+	var date Date
+	date, err = parseISO("2000" + rest)
+	if err != nil {
+		return Date{}, err
+	}
+	return PROLEPTIC.New(date.month, date.day, Year(1-year))
+}
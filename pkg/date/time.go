@@ -0,0 +1,163 @@
+// ----------------------------------------------------------------------------
+//
+// Time
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements the conversion of dates to and from the instants of
+// the time package.  A date is a calendar day with no time zone, so every
+// conversion to an instant takes an explicit location.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"time"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// FromTime returns the calendar date of the instant in the location of the
+// instant.  To obtain the date in another location, use t.In(loc) first.
+// An error is returned if the year is outside the range of dates.
+func FromTime(t time.Time) (Date, error) {
+	return STANDARD.FromTime(t)
+}
+
+// FromTime returns the calendar date of the instant in the location of the
+// instant, as in the function FromTime.  An error is returned if the year is
+// outside the range of the mode.
+func (mode Mode) FromTime(t time.Time) (Date, error) {
+	var date, err = mode.New(Month(t.Month()), Day(t.Day()), Year(t.Year()))
+	if err != nil {
+		return Date{}, errors.New("date.FromTime: " + err.Error())
+	}
+	return date, nil
+}
+
+// FromTimeMonth converts a time.Month into a month.
+func FromTimeMonth(month time.Month) (Month, error) {
+	var err = isMonth(Month(month))
+	if err != nil {
+		return 0, err
+	}
+	return Month(month), nil
+}
+
+// FromTimeWeekday converts a time.Weekday into a day of the week.  Both types
+// number the days from Sunday as 0.
+func FromTimeWeekday(weekday time.Weekday) (DayOfWeek, error) {
+	var err = isDayOfWeek(DayOfWeek(weekday))
+	if err != nil {
+		return SUNDAY, err
+	}
+	return DayOfWeek(weekday), nil
+}
+
+// isLocation returns an error if the location is nil.
+func isLocation(loc *time.Location) error {
+	var err error = nil
+	if loc == nil {
+		err = errors.New("location must not be nil")
+	}
+	return err
+}
+
+// startOfDay returns the first instant of the day in the location.  The
+// year, month, and day are normalized as in time.Date.  Where a daylight
+// saving transition skips midnight, the day begins at the transition, which
+// time.Date may place either before or after the missing hour.
+func startOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	var t = time.Date(year, month, day, 0, 0, 0, 0, loc)
+	switch {
+	case t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0:
+		// midnight exists
+	case t.Hour() >= 12:
+		// time.Date moved back into the previous day
+		var _, end = t.ZoneBounds()
+		t = end
+	default:
+		// time.Date moved forward past the transition
+		var start, _ = t.ZoneBounds()
+		t = start
+	}
+	return t
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// In returns midnight at the beginning of the date in the location.  If
+// midnight does not exist in the location because of a daylight saving
+// transition, the result is normalized as in time.Date.  Use StartOf for the
+// first instant of the date.
+//
+// Precondition:
+//
+//	IsADate(date) and loc != nil
+func (date Date) In(loc *time.Location) time.Time {
+	assert.Precondition(IsADate(date))
+	assert.Precondition(isLocation(loc))
+	return time.Date(int(date.year), date.month.TimeMonth(), int(date.day), 0, 0, 0, 0, loc)
+}
+
+// StartOf returns the first instant of the date in the location.  This is
+// midnight, except where a daylight saving transition skips midnight, in
+// which case the date begins at the transition.
+//
+// Precondition:
+//
+//	IsADate(date) and loc != nil
+func (date Date) StartOf(loc *time.Location) time.Time {
+	assert.Precondition(IsADate(date))
+	assert.Precondition(isLocation(loc))
+	return startOfDay(int(date.year), date.month.TimeMonth(), int(date.day), loc)
+}
+
+// EndOf returns the last instant of the date in the location, one nanosecond
+// before the start of the following date.  The day is not assumed to have
+// 24 hours.
+//
+// Precondition:
+//
+//	IsADate(date) and loc != nil
+func (date Date) EndOf(loc *time.Location) time.Time {
+	assert.Precondition(IsADate(date))
+	assert.Precondition(isLocation(loc))
+	var next = startOfDay(int(date.year), date.month.TimeMonth(), int(date.day)+1, loc)
+	return next.Add(-time.Nanosecond)
+}
+
+// TimeMonth converts the month into a time.Month.
+//
+// Precondition:
+//
+//	isMonth(month)
+func (month Month) TimeMonth() time.Month {
+	assert.Precondition(isMonth(month))
+	return time.Month(month)
+}
+
+// TimeWeekday converts the day of the week into a time.Weekday.
+//
+// Precondition:
+//
+//	isDayOfWeek(dayOfWeek)
+func (dayOfWeek DayOfWeek) TimeWeekday() time.Weekday {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+	return time.Weekday(dayOfWeek)
+}
@@ -60,7 +60,7 @@ var weekDays = []DayOfWeek{
 // 0 <= dayOfWeek < 7
 func isDayOfWeek(dayOfWeek DayOfWeek) error {
 	var err error = nil
-	if slices.Contains(weekDays, dayOfWeek) {
+	if !slices.Contains(weekDays, dayOfWeek) {
 		err = errors.New("day of week must be between 0 and 6, not " + strconv.Itoa(int(dayOfWeek)))
 	}
 	return err
@@ -77,80 +77,129 @@ func (date Date) WeekDay() (DayOfWeek, error) {
 	if err != nil {
 		return SUNDAY, err
 	}
-	var weekDay = DayOfWeek(absoluteDate % 7)
+	var weekDay = DayOfWeek(floorMod(int(absoluteDate), 7))
 	// Postcondition:
 	//   isDayOfWeek(weekDay) and
 	//   WeekDay(date.Increment()) = daysOfWeek[(WeekDay(date) + 1) mod 7]
 	return weekDay, nil
 }
 
+// weekDayDifference returns the number of days from the first day of the
+// week forward to the second day of the week, from 0 through 6.
+func weekDayDifference(from DayOfWeek, to DayOfWeek) int {
+	return floorMod(int(to)-int(from), 7)
+}
+
 // DayOfWeekAfter returns the closest date after the specified date
 // with the specified day of the week.
 func (date Date) DayOfWeekAfter(dayOfWeek DayOfWeek) (Date, error) {
-	var laterDate Date
-	var err error
-	var weekday DayOfWeek
-
 	assert.Precondition(isDayOfWeek(dayOfWeek))
 
-	laterDate, err = date.Increment()
+	var weekDay, err = date.WeekDay()
 	if err != nil {
 		return date, err
 	}
+	return Add(date, weekDayDifference(weekDay+1, dayOfWeek)+1)
+}
 
-	for {
-		weekday, err = laterDate.WeekDay()
-		if err != nil {
-			break
-		}
-		if weekday == dayOfWeek {
-			break
-		}
-		laterDate, err = laterDate.Increment()
-		if err != nil {
-			break
-		}
+// DayOfWeekOnOrAfter returns the date with the specified day of the week
+// that is the date itself or the closest date after it.
+func (date Date) DayOfWeekOnOrAfter(dayOfWeek DayOfWeek) (Date, error) {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+
+	var weekDay, err = date.WeekDay()
+	if err != nil {
+		return date, err
 	}
-	return laterDate, err
+	return Add(date, weekDayDifference(weekDay, dayOfWeek))
 }
 
-// LastWeekDayOfMonth returns the date of the last specified day of the week
-// in a specified month and year.
-func LastWeekDayOfMonth(month Month, year Year, dayOfWeek DayOfWeek) (Date, error) {
-	var err error
-	var lastDay int
-	var result Date
-	var dayWeek DayOfWeek
-
-	assert.Precondition(isMonth(month))
-	assert.Precondition(isYear(year))
+// DayOfWeekBefore returns the closest date before the specified date
+// with the specified day of the week.
+func (date Date) DayOfWeekBefore(dayOfWeek DayOfWeek) (Date, error) {
 	assert.Precondition(isDayOfWeek(dayOfWeek))
 
-	lastDay, err = DaysInMonth(month, year)
+	var weekDay, err = date.WeekDay()
 	if err != nil {
-		return MinDate, err
+		return date, err
 	}
-	result, err = New(month, Day(lastDay), year)
+	return Add(date, -weekDayDifference(dayOfWeek, weekDay-1)-1)
+}
+
+// DayOfWeekOnOrBefore returns the date with the specified day of the week
+// that is the date itself or the closest date before it.
+func (date Date) DayOfWeekOnOrBefore(dayOfWeek DayOfWeek) (Date, error) {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+
+	var weekDay, err = date.WeekDay()
 	if err != nil {
-		return MinDate, err
+		return date, err
 	}
-	dayWeek, err = result.WeekDay()
+	return Add(date, -weekDayDifference(dayOfWeek, weekDay))
+}
+
+// NearestDayOfWeek returns the date with the specified day of the week that
+// is closest to the specified date.  The result is never more than three
+// days from the date, so there are no ties.
+func (date Date) NearestDayOfWeek(dayOfWeek DayOfWeek) (Date, error) {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+
+	var weekDay, err = date.WeekDay()
 	if err != nil {
-		return result, err
+		return date, err
 	}
+	return Add(date, weekDayDifference(weekDay, dayOfWeek+3)-3)
+}
 
-	for {
-		if dayWeek == dayOfWeek {
-			break
+// NthWeekDayOfMonth returns the date of the nth specified day of the week in
+// a specified month and year, for example the third Wednesday in March.  If n
+// is negative, it counts from the end of the month, so -1 is the last and -2
+// is the second-to-last such day.  An error is returned if n is zero or the
+// month does not have an nth such day.
+func NthWeekDayOfMonth(n int, dayOfWeek DayOfWeek, month Month, year Year) (Date, error) {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+
+	var lastDay, err = DaysInMonth(month, year)
+	if err != nil {
+		return MinDate, err
+	}
+	var result Date
+	switch {
+	case n > 0:
+		result, err = New(month, 1, year)
+		if err == nil {
+			result, err = result.DayOfWeekOnOrAfter(dayOfWeek)
+		}
+		if err == nil {
+			result, err = Add(result, 7*(n-1))
 		}
-		result, err = result.Decrement()
-		if err != nil {
-			break
+	case n < 0:
+		result, err = New(month, Day(lastDay), year)
+		if err == nil {
+			result, err = result.DayOfWeekOnOrBefore(dayOfWeek)
 		}
-		dayWeek, err = result.WeekDay()
-		if err != nil {
-			break
+		if err == nil {
+			result, err = Add(result, 7*(n+1))
 		}
+	default:
+		err = errors.New("occurrence of the day of the week must not be 0")
 	}
-	return result, err
+	if err == nil && (result.month != month || result.year != year) {
+		err = errors.New(MonthName(month) + " " + strconv.Itoa(int(year)) + " does not have occurrence " +
+			strconv.Itoa(n) + " of day of the week " + strconv.Itoa(int(dayOfWeek)))
+	}
+	if err != nil {
+		return MinDate, err
+	}
+	return result, nil
+}
+
+// LastWeekDayOfMonth returns the date of the last specified day of the week
+// in a specified month and year.
+func LastWeekDayOfMonth(month Month, year Year, dayOfWeek DayOfWeek) (Date, error) {
+	assert.Precondition(isMonth(month))
+	assert.Precondition(isYear(year))
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+
+	return NthWeekDayOfMonth(-1, dayOfWeek, month, year)
 }
@@ -0,0 +1,128 @@
+// ----------------------------------------------------------------------------
+//
+// Weekend
+//
+// Author: William Shaffer
+// Version: 16-October-2026
+//
+// Copyright (c) 2026 William Shaffer All Rights Reserved
+//
+// ----------------------------------------------------------------------------
+
+package date
+
+// This file implements weekend definitions.  A weekend is the set of days of
+// the week that are not working days.  The remaining days form the work week.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/waysys/assert/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Weekend is a set of days of the week that are not working days.  A weekend
+// has at most six days.  The zero value is a weekend with no days, so every
+// day is a work day.
+type Weekend struct {
+	days [7]bool
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// SaturdaySunday is the weekend of Saturday and Sunday.
+var SaturdaySunday, _ = NewWeekend(SATURDAY, SUNDAY)
+
+// FridaySaturday is the weekend of Friday and Saturday.
+var FridaySaturday, _ = NewWeekend(FRIDAY, SATURDAY)
+
+// SundayOnly is the weekend of a six-day work week.
+var SundayOnly, _ = NewWeekend(SUNDAY)
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewWeekend returns the weekend consisting of the specified days of the
+// week.  Repeated days are ignored.  A weekend must leave at least one work
+// day.  With no days, it returns a weekend with no days.
+func NewWeekend(days ...DayOfWeek) (Weekend, error) {
+	var err error
+	var weekend = Weekend{}
+
+	for _, dayOfWeek := range days {
+		err = isDayOfWeek(dayOfWeek)
+		if err != nil {
+			return Weekend{}, err
+		}
+		weekend.days[dayOfWeek] = true
+	}
+	if weekend.WorkDaysPerWeek() == 0 {
+		return Weekend{}, errors.New("weekend must leave at least one work day")
+	}
+	return weekend, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Contains returns true if the day of the week is in the weekend.
+func (weekend Weekend) Contains(dayOfWeek DayOfWeek) bool {
+	assert.Precondition(isDayOfWeek(dayOfWeek))
+	return weekend.days[dayOfWeek]
+}
+
+// IsWeekend returns true if the date falls on the weekend.
+func (weekend Weekend) IsWeekend(date Date) bool {
+	var dayOfWeek, err = date.WeekDay()
+	assert.Precondition(err)
+	return weekend.days[dayOfWeek]
+}
+
+// IsWorkDay returns true if the date does not fall on the weekend.
+func (weekend Weekend) IsWorkDay(date Date) bool {
+	return !weekend.IsWeekend(date)
+}
+
+// Days returns the days of the weekend in order from Sunday.
+func (weekend Weekend) Days() []DayOfWeek {
+	var days []DayOfWeek
+	for _, dayOfWeek := range weekDays {
+		if weekend.days[dayOfWeek] {
+			days = append(days, dayOfWeek)
+		}
+	}
+	return days
+}
+
+// WorkDaysPerWeek returns the number of days in a week that are not in the
+// weekend.
+func (weekend Weekend) WorkDaysPerWeek() int {
+	var count = 0
+	for _, isWeekend := range weekend.days {
+		if !isWeekend {
+			count++
+		}
+	}
+	return count
+}
+
+// String returns the names of the days of the weekend separated by commas.
+func (weekend Weekend) String() string {
+	var names []string
+	for _, dayOfWeek := range weekend.Days() {
+		names = append(names, WeekDayName(dayOfWeek))
+	}
+	return strings.Join(names, ",")
+}
@@ -59,6 +59,67 @@ func New(first d.Date, last d.Date) (DateRange, error) {
 	return dateRange, err
 }
 
+// NewFromQuarter creates a date range from the first date through the last
+// date of a quarter.
+func NewFromQuarter(quarter d.Quarter) (DateRange, error) {
+	var _, err = d.NewQuarter(quarter.Year, quarter.Number)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(quarter.First(), quarter.Last())
+}
+
+// WeekOf creates a date range from the first date through the last date of
+// the week containing a date, where the week begins on the specified day of
+// the week.  An error is returned if the week extends outside the range of
+// dates.
+func WeekOf(date d.Date, firstDayOfWeek d.DayOfWeek) (DateRange, error) {
+	var err = d.IsADate(date)
+	if err != nil {
+		return errorRange, err
+	}
+	var first, last d.Date
+	first, err = date.StartOfWeek(firstDayOfWeek)
+	if err != nil {
+		return errorRange, err
+	}
+	last, err = date.EndOfWeek(firstDayOfWeek)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(first, last)
+}
+
+// MonthOf creates a date range from the first date through the last date of
+// the month containing a date.
+func MonthOf(date d.Date) (DateRange, error) {
+	var err = d.IsADate(date)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(date.StartOfMonth(), date.EndOfMonth())
+}
+
+// QuarterOf creates a date range from the first date through the last date
+// of the calendar quarter containing a date.
+func QuarterOf(date d.Date) (DateRange, error) {
+	var err = d.IsADate(date)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(date.StartOfQuarter(), date.EndOfQuarter())
+}
+
+// YearOf creates a date range from the first date through the last date of
+// the year containing a date.
+func YearOf(date d.Date) (DateRange, error) {
+	var err = d.IsADate(date)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(date.StartOfYear(), date.EndOfYear())
+}
+
 // IsDateRange returns an error is the date range is  not valid.
 func IsDateRange(dateRange DateRange) error {
 	var err error = nil
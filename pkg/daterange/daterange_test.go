@@ -6,6 +6,7 @@ package daterange
 // ----------------------------------------------------------------------------
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"os"
 	"strconv"
@@ -100,3 +101,311 @@ func Test_InRange(t *testing.T) {
 		t.Error("InRange says date3 is in date range")
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Test database functions
+// ----------------------------------------------------------------------------
+
+// Test_Scan checks the conversion of Postgres daterange literals into date ranges.
+func Test_Scan(t *testing.T) {
+	type aTest struct {
+		name    string
+		literal string
+		first   date.Date
+		last    date.Date
+		error   bool
+	}
+	var jan31, _ = date.New(1, 31, 2023)
+	var feb2, _ = date.New(2, 2, 2023)
+	var ides, _ = date.PROLEPTIC.New(3, 15, -44)
+	var yearZero, _ = date.PROLEPTIC.New(12, 31, 0)
+	var data = []aTest{
+		{"canonical", "[2023-01-01,2023-02-01)", date1, jan31, false},
+		{"inclusive", "[2023-01-01,2023-02-01]", date1, date2, false},
+		{"exclusive lower", "(2022-12-31,2023-02-01]", date1, date2, false},
+		{"unbounded lower", "(,2023-02-01]", date.MinDate, date2, false},
+		{"unbounded upper", "[2023-01-01,)", date1, date.MaxDate, false},
+		{"day after MaxDate", "[2023-02-02,4000-01-01)", feb2, date.MaxDate, false},
+		{"day before MinDate", "(1600-12-31,2023-02-01]", date.MinDate, date2, false},
+		{"infinite", "[-infinity,infinity)", date.MinDate, date.MaxDate, false},
+		{"infinite exclusive", "(-infinity,2023-02-02)", date.MinDate, date2, false},
+		{"BC dates", "[0045-03-15 BC,0001-12-31 BC]", ides, yearZero, false},
+		{"empty", "empty", date1, date1, true},
+		{"empty bounds", "[2023-01-01,2023-01-01)", date1, date1, true},
+		{"missing bracket", "2023-01-01,2023-02-01", date1, date1, true},
+		{"bad date", "[2023-01-01,2023-02-30)", date1, date1, true},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var dateRange DateRange
+		var err = dateRange.Scan(tt.literal)
+		switch {
+		case tt.error && err == nil:
+			t.Fatalf("Scan should have reported error for %s", tt.literal)
+		case tt.error:
+			fmt.Println(err)
+		case err != nil:
+			t.Fatalf("Scan incorrectly reported an error for %s: %s", tt.literal, err)
+		case dateRange.First() != tt.first || dateRange.Last() != tt.last:
+			t.Fatalf("Scan returned wrong date range %s for %s", dateRange, tt.literal)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+}
+
+// Test_Value checks the conversion of date ranges into Postgres daterange literals.
+func Test_Value(t *testing.T) {
+	var dateRange, err = New(date1, date2)
+	handle(err, t)
+	var value, err2 = dateRange.Value()
+	handle(err2, t)
+	if value != "[2023-01-01,2023-02-02)" {
+		t.Errorf("Wrong daterange literal: %v", value)
+	}
+
+	type aTest struct {
+		name    string
+		first   date.Date
+		last    date.Date
+		literal string
+	}
+	var ides, _ = date.PROLEPTIC.New(3, 15, -44)
+	var yearZero, _ = date.PROLEPTIC.New(12, 31, 0)
+	var data = []aTest{
+		{"unbounded upper", date1, date.MaxDate, "[2023-01-01,)"},
+		{"unbounded lower", date.MinDate, date2, "(,2023-02-02)"},
+		{"unbounded", date.MinDate, date.MaxDate, "(,)"},
+		{"BC dates", ides, yearZero, "[0045-03-15 BC,0001-01-01)"},
+		{"ProlepticMaxDate", date1, date.ProlepticMaxDate, "[2023-01-01,9999-12-31]"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var dateRange, err = New(tt.first, tt.last)
+		handle(err, t)
+		var value driver.Value
+		value, err = dateRange.Value()
+		handle(err, t)
+		if value != tt.literal {
+			t.Fatalf("Wrong daterange literal: %v", value)
+		}
+		var scanned DateRange
+		err = scanned.Scan(value)
+		handle(err, t)
+		if scanned != dateRange {
+			t.Fatalf("Round trip produced wrong date range: %s", scanned)
+		}
+	}
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var tooEarly, _ = date.PROLEPTIC.New(1, 1, -5000)
+	dateRange, err = New(tooEarly, date1)
+	handle(err, t)
+	_, err = dateRange.Value()
+	if err == nil {
+		t.Error("Value did not detect a date Postgres cannot store")
+	} else {
+		fmt.Println(err)
+	}
+}
+
+// Test_NewFromQuarter checks the creation of date ranges from quarters.
+func Test_NewFromQuarter(t *testing.T) {
+	var quarter, err = date.NewQuarter(2024, 1)
+	handle(err, t)
+	var dateRange, err2 = NewFromQuarter(quarter)
+	handle(err2, t)
+	if dateRange.String() != "(01-Jan-2024,31-Mar-2024)" {
+		t.Errorf("Wrong date range for %s: %s", quarter, dateRange)
+	}
+	_, err = NewFromQuarter(date.Quarter{Year: 2024, Number: 5})
+	if err == nil {
+		t.Error("NewFromQuarter did not detect an invalid quarter")
+	}
+}
+
+// Test_PeriodOf checks the creation of date ranges for the periods
+// containing a date.
+func Test_PeriodOf(t *testing.T) {
+	type aTest struct {
+		name     string
+		periodOf func(value date.Date) (DateRange, error)
+		expected string
+	}
+
+	var value, err = date.New(8, 15, 2024)
+	handle(err, t)
+	var weekOf = func(value date.Date) (DateRange, error) {
+		return WeekOf(value, date.MONDAY)
+	}
+
+	var data = []aTest{
+		{"week", weekOf, "(12-Aug-2024,18-Aug-2024)"},
+		{"month", MonthOf, "(01-Aug-2024,31-Aug-2024)"},
+		{"quarter", QuarterOf, "(01-Jul-2024,30-Sep-2024)"},
+		{"year", YearOf, "(01-Jan-2024,31-Dec-2024)"},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var dateRange, err = tt.periodOf(value)
+		handle(err, t)
+		if dateRange.String() != tt.expected {
+			t.Fatalf("Wrong %s of %s: %s", tt.name, value, dateRange)
+		}
+		if !dateRange.InRange(value) {
+			t.Fatalf("Date %s is not in its %s: %s", value, tt.name, dateRange)
+		}
+	}
+
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	_, err = MonthOf(date.Date{})
+	if err == nil {
+		t.Error("MonthOf did not detect an invalid date")
+	}
+	_, err = WeekOf(date.MaxDate, date.SUNDAY)
+	if err == nil {
+		t.Error("WeekOf did not detect a week ending after the maximum date")
+	}
+	_, err = WeekOf(date.MinDate, date.SUNDAY)
+	if err == nil {
+		t.Error("WeekOf did not detect a week beginning before the minimum date")
+	} else {
+		fmt.Println(err)
+	}
+
+	var ides, _ = date.PROLEPTIC.New(3, 15, -44)
+	var expected = []string{
+		"(12-Mar--0044,18-Mar--0044)",
+		"(01-Mar--0044,31-Mar--0044)",
+		"(01-Jan--0044,31-Mar--0044)",
+		"(01-Jan--0044,31-Dec--0044)",
+	}
+	for index, item := range data {
+		var dateRange, err = item.periodOf(ides)
+		handle(err, t)
+		if dateRange.String() != expected[index] {
+			t.Errorf("Wrong %s of %s: %s", item.name, ides, dateRange)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test reporting windows
+// ----------------------------------------------------------------------------
+
+// Test_Windows checks the reporting windows computed from an anchor date.
+func Test_Windows(t *testing.T) {
+	type aTest struct {
+		name     string
+		window   func(anchor date.Date) (DateRange, error)
+		anchor   string
+		expected string
+	}
+
+	var trailingDays = func(days int) func(anchor date.Date) (DateRange, error) {
+		return func(anchor date.Date) (DateRange, error) {
+			return TrailingDays(anchor, days)
+		}
+	}
+	var trailingMonths = func(months int) func(anchor date.Date) (DateRange, error) {
+		return func(anchor date.Date) (DateRange, error) {
+			return TrailingMonths(anchor, months)
+		}
+	}
+	var lastYear = func(window func(anchor date.Date) (DateRange, error)) func(anchor date.Date) (DateRange, error) {
+		return func(anchor date.Date) (DateRange, error) {
+			var dateRange, err = window(anchor)
+			if err != nil {
+				return dateRange, err
+			}
+			return SamePeriodLastYear(dateRange)
+		}
+	}
+
+	var data = []aTest{
+		{"month to date", MonthToDate, "2024-05-15", "(01-May-2024,15-May-2024)"},
+		{"month to date first day", MonthToDate, "2024-05-01", "(01-May-2024,01-May-2024)"},
+		{"quarter to date", QuarterToDate, "2024-05-15", "(01-Apr-2024,15-May-2024)"},
+		{"year to date", YearToDate, "2024-05-15", "(01-Jan-2024,15-May-2024)"},
+		{"prior month", PriorMonth, "2024-03-31", "(01-Feb-2024,29-Feb-2024)"},
+		{"prior month January", PriorMonth, "2024-01-10", "(01-Dec-2023,31-Dec-2023)"},
+		{"last year month to date", lastYear(MonthToDate), "2024-05-15", "(01-May-2023,15-May-2023)"},
+		{"last year from leap day", lastYear(MonthToDate), "2024-02-29", "(01-Feb-2023,28-Feb-2023)"},
+		{"last year to leap day", lastYear(YearToDate), "2025-02-28", "(01-Jan-2024,29-Feb-2024)"},
+		{"last year mid February", lastYear(YearToDate), "2025-02-27", "(01-Jan-2024,27-Feb-2024)"},
+		{"trailing 1 day", trailingDays(1), "2024-03-01", "(01-Mar-2024,01-Mar-2024)"},
+		{"trailing 7 days", trailingDays(7), "2024-03-03", "(26-Feb-2024,03-Mar-2024)"},
+		{"trailing 3 months", trailingMonths(3), "2024-05-15", "(16-Feb-2024,15-May-2024)"},
+		{"trailing month end", trailingMonths(1), "2024-02-29", "(01-Feb-2024,29-Feb-2024)"},
+		{"trailing month 30th", trailingMonths(1), "2024-03-30", "(01-Mar-2024,30-Mar-2024)"},
+		{"trailing month 28th", trailingMonths(1), "2024-03-28", "(29-Feb-2024,28-Mar-2024)"},
+		{"trailing twelve months", TrailingTwelveMonths, "2024-06-15", "(16-Jun-2023,15-Jun-2024)"},
+		{"trailing twelve months leap day", TrailingTwelveMonths, "2024-02-29", "(01-Mar-2023,29-Feb-2024)"},
+		{"trailing twelve months to February", TrailingTwelveMonths, "2025-02-28", "(01-Mar-2024,28-Feb-2025)"},
+		{"trailing month 31st", trailingMonths(1), "2024-03-31", "(01-Mar-2024,31-Mar-2024)"},
+		{"trailing 3 months 31st", trailingMonths(3), "2023-05-31", "(01-Mar-2023,31-May-2023)"},
+		{"trailing 2 months 31st", trailingMonths(2), "2024-03-31", "(01-Feb-2024,31-Mar-2024)"},
+		{"prior month 31st", PriorMonth, "2023-03-31", "(01-Feb-2023,28-Feb-2023)"},
+		{"prior month 31-May", PriorMonth, "2024-05-31", "(01-Apr-2024,30-Apr-2024)"},
+		{"last year trailing leap day", lastYear(trailingDays(1)), "2024-02-29", "(28-Feb-2023,28-Feb-2023)"},
+		{"last year trailing week", lastYear(trailingDays(7)), "2024-02-29", "(23-Feb-2023,28-Feb-2023)"},
+		{"last year to leap year", lastYear(MonthToDate), "2025-02-28", "(01-Feb-2024,29-Feb-2024)"},
+		{"month to date MaxDate", MonthToDate, "3999-12-31", "(01-Dec-3999,31-Dec-3999)"},
+		{"quarter to date MaxDate", QuarterToDate, "3999-12-31", "(01-Oct-3999,31-Dec-3999)"},
+		{"year to date MaxDate", YearToDate, "3999-12-31", "(01-Jan-3999,31-Dec-3999)"},
+		{"trailing days MaxDate", trailingDays(7), "3999-12-31", "(25-Dec-3999,31-Dec-3999)"},
+		{"trailing day MinDate", trailingDays(1), "1601-01-01", "(01-Jan-1601,01-Jan-1601)"},
+		{"year to date MinDate", YearToDate, "1601-01-01", "(01-Jan-1601,01-Jan-1601)"},
+		{"zero days", trailingDays(0), "2023-01-01", ""},
+		{"negative months", trailingMonths(-1), "2023-01-01", ""},
+		{"prior month MinDate", PriorMonth, "1601-01-31", ""},
+		{"trailing days before MinDate", trailingDays(2), "1601-01-01", ""},
+		{"trailing month before MinDate", trailingMonths(1), "1601-01-15", ""},
+		{"trailing twelve months before MinDate", TrailingTwelveMonths, "1601-12-30", ""},
+		{"last year before MinDate", lastYear(YearToDate), "1601-06-30", ""},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var anchor, err = date.Parse("yyyy-MM-dd", tt.anchor)
+		handle(err, t)
+		var dateRange DateRange
+		dateRange, err = tt.window(anchor)
+		switch {
+		case tt.expected == "" && err == nil:
+			t.Fatalf("Window for %s did not report an error: %s", anchor, dateRange)
+		case tt.expected == "":
+			fmt.Println(err)
+		case err != nil:
+			t.Fatalf("Window for %s incorrectly reported an error: %s", anchor, err)
+		case dateRange.String() != tt.expected:
+			t.Fatalf("Wrong window for %s: %s, not %s", anchor, dateRange, tt.expected)
+		}
+	}
+
+	for _, d := range data {
+		tt = d
+		t.Run(d.name, testFunction)
+	}
+
+	var _, err = MonthToDate(date.Date{})
+	if err == nil {
+		t.Error("MonthToDate did not detect an invalid anchor date")
+	}
+	_, err = SamePeriodLastYear(DateRange{})
+	if err == nil {
+		t.Error("SamePeriodLastYear did not detect an invalid date range")
+	}
+}
@@ -0,0 +1,170 @@
+package daterange
+
+// This file implements the database/sql interfaces for date ranges using the
+// Postgres daterange literal syntax, for example [2024-01-01,2024-02-01).
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	d "github.com/waysys/waydate/pkg/date"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// infinity and minusInfinity are the Postgres bounds later and earlier than
+// all dates.  A range bound of infinity or -infinity is unbounded.
+const (
+	infinity      = "infinity"
+	minusInfinity = "-infinity"
+)
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Scan implements the sql.Scanner interface.  The source must be a string or
+// []byte holding a Postgres daterange literal such as [2024-01-01,2024-02-01).
+// Exclusive bounds are converted to the inclusive first and last dates of
+// the date range, so the example yields (01-Jan-2024,31-Jan-2024).  An
+// unbounded lower or upper bound, including a bound of -infinity or infinity,
+// is converted to MinDate or MaxDate.  An empty range is an error because a
+// DateRange always has at least one date.  A NULL value sets the date range
+// to the zero value.
+func (dateRange *DateRange) Scan(src any) error {
+	var err error
+	var result DateRange
+
+	switch value := src.(type) {
+	case nil:
+		result = DateRange{}
+	case string:
+		result, err = parseLiteral(value)
+	case []byte:
+		result, err = parseLiteral(string(value))
+	default:
+		err = fmt.Errorf("cannot convert %T to a date range", src)
+	}
+	if err != nil {
+		return errors.New("daterange.Scan: " + err.Error())
+	}
+	*dateRange = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface.  The date range is sent to
+// the database in the canonical Postgres form with an inclusive lower bound
+// and an exclusive upper bound, for example [2024-01-01,2024-02-01).  A date
+// range starting on MinDate or ending on MaxDate is sent with an unbounded
+// lower or upper bound, for example [2024-01-01,), since Scan converts an
+// unbounded bound to MinDate or MaxDate.  The zero value of DateRange is
+// sent as NULL.
+func (dateRange DateRange) Value() (driver.Value, error) {
+	var err error
+	var lower, upper string
+	var opening, closing = "(", ")"
+
+	if dateRange == (DateRange{}) {
+		return nil, nil
+	}
+	err = IsDateRange(dateRange)
+	if err == nil && dateRange.first != d.MinDate {
+		opening = "["
+		lower, err = boundValue(dateRange.first)
+	}
+	if err == nil && dateRange.last == d.ProlepticMaxDate {
+		closing = "]"
+		upper, err = boundValue(dateRange.last)
+	} else if err == nil && dateRange.last != d.MaxDate {
+		var next d.Date
+		next, err = d.PROLEPTIC.Add(dateRange.last, 1)
+		if err == nil {
+			upper, err = boundValue(next)
+		}
+	}
+	if err != nil {
+		return nil, errors.New("daterange.Value: " + err.Error())
+	}
+	var literal = opening + lower + "," + upper + closing
+	return literal, nil
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// parseLiteral converts a Postgres daterange literal into a date range.
+func parseLiteral(literal string) (DateRange, error) {
+	var err error
+	var first d.Date
+	var last d.Date
+
+	var value = strings.TrimSpace(literal)
+	if strings.EqualFold(value, "empty") {
+		return errorRange, errors.New("empty range cannot be converted to a date range")
+	}
+	if len(value) < 3 {
+		return errorRange, errors.New("invalid daterange literal: " + strconv.Quote(literal))
+	}
+	var opening = value[0]
+	var closing = value[len(value)-1]
+	if (opening != '[' && opening != '(') || (closing != ']' && closing != ')') {
+		return errorRange, errors.New("invalid daterange bounds: " + strconv.Quote(literal))
+	}
+	var bounds = strings.Split(value[1:len(value)-1], ",")
+	if len(bounds) != 2 {
+		return errorRange, errors.New("daterange literal must have two bounds: " + strconv.Quote(literal))
+	}
+	var lower = strings.Trim(strings.TrimSpace(bounds[0]), `"`)
+	var upper = strings.Trim(strings.TrimSpace(bounds[1]), `"`)
+	//
+	// Obtain first date
+	//
+	if lower == "" || lower == minusInfinity {
+		first = d.MinDate
+	} else {
+		err = first.Scan(lower)
+		if err == nil && opening == '(' {
+			first, err = d.PROLEPTIC.Add(first, 1)
+		}
+	}
+	if err != nil {
+		return errorRange, errors.New("invalid lower bound: " + err.Error())
+	}
+	//
+	// Obtain last date
+	//
+	if upper == "" || upper == infinity {
+		last = d.MaxDate
+	} else {
+		err = last.Scan(upper)
+		if err == nil && closing == ')' {
+			last, err = d.PROLEPTIC.Add(last, -1)
+		}
+	}
+	if err != nil {
+		return errorRange, errors.New("invalid upper bound: " + err.Error())
+	}
+	//
+	// Create date range
+	//
+	return New(first, last)
+}
+
+// boundValue returns the date as a bound of a Postgres daterange literal.
+func boundValue(date d.Date) (string, error) {
+	var value, err = date.Value()
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
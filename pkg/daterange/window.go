@@ -0,0 +1,171 @@
+package daterange
+
+// This file implements the reporting windows used in dashboards and
+// reports, such as month-to-date and trailing twelve months.  Each window is
+// computed from an anchor date, which is usually today, and includes the
+// anchor date unless stated otherwise.
+//
+// Windows that move back by months or years follow these rules at month ends
+// and leap days:
+//
+//   - A day that does not exist in the earlier month is moved to the last
+//     day of that month, so a year before 29-Feb-2024 is 28-Feb-2023.
+//   - The last day of a month, when it is the last date of a window, stays
+//     the last day of the month, so a year before 28-Feb-2025 is 29-Feb-2024.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	d "github.com/waysys/waydate/pkg/date"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// MonthsInTrailingYear is the number of months in the trailing twelve
+// months window.
+const MonthsInTrailingYear = 12
+
+// firstDatePolicy moves a first date by months.  A day that does not exist
+// in the target month is moved to the end of that month.
+var firstDatePolicy = d.MonthPolicy{InvalidDay: d.CLAMP}
+
+// lastDatePolicy moves a last date by months.  It also keeps the last day of
+// a month as the last day of the target month.
+var lastDatePolicy = d.MonthPolicy{InvalidDay: d.CLAMP, StickyEndOfMonth: true}
+
+// ----------------------------------------------------------------------------
+// Period to date
+// ----------------------------------------------------------------------------
+
+// MonthToDate returns the date range from the first date of the month
+// containing the anchor date through the anchor date.
+func MonthToDate(anchor d.Date) (DateRange, error) {
+	var err = d.IsADate(anchor)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(anchor.StartOfMonth(), anchor)
+}
+
+// QuarterToDate returns the date range from the first date of the calendar
+// quarter containing the anchor date through the anchor date.
+func QuarterToDate(anchor d.Date) (DateRange, error) {
+	var err = d.IsADate(anchor)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(anchor.StartOfQuarter(), anchor)
+}
+
+// YearToDate returns the date range from 1 January of the year containing
+// the anchor date through the anchor date.
+func YearToDate(anchor d.Date) (DateRange, error) {
+	var err = d.IsADate(anchor)
+	if err != nil {
+		return errorRange, err
+	}
+	return New(anchor.StartOfYear(), anchor)
+}
+
+// ----------------------------------------------------------------------------
+// Prior periods
+// ----------------------------------------------------------------------------
+
+// PriorMonth returns the date range of the whole calendar month before the
+// month containing the anchor date.  The anchor date is not in the range.
+func PriorMonth(anchor d.Date) (DateRange, error) {
+	var err = d.IsADate(anchor)
+	if err != nil {
+		return errorRange, err
+	}
+	var lastDate d.Date
+	lastDate, err = d.Add(anchor.StartOfMonth(), -1)
+	if err != nil {
+		return errorRange, errors.New("daterange.PriorMonth: no month before " + anchor.String())
+	}
+	return MonthOf(lastDate)
+}
+
+// SamePeriodLastYear returns the date range one year before the date range,
+// for example the month-to-date window of the previous year.  A first or
+// last date of 29-Feb is moved to 28-Feb.  A last date at the end of
+// February stays at the end of February, so a window ending 28-Feb-2025 is
+// compared with one ending 29-Feb-2024.
+func SamePeriodLastYear(dateRange DateRange) (DateRange, error) {
+	var err = IsDateRange(dateRange)
+	if err != nil {
+		return errorRange, err
+	}
+	var first, last d.Date
+	first, err = d.AddYears(dateRange.first, -1, firstDatePolicy)
+	if err == nil {
+		last, err = d.AddYears(dateRange.last, -1, lastDatePolicy)
+	}
+	if err != nil {
+		return errorRange, errors.New("daterange.SamePeriodLastYear: " + err.Error())
+	}
+	return New(first, last)
+}
+
+// ----------------------------------------------------------------------------
+// Trailing windows
+// ----------------------------------------------------------------------------
+
+// TrailingDays returns the date range of the number of days ending on the
+// anchor date, so a trailing window of 7 days ending on a Sunday begins on
+// the Monday before.  The number of days must be at least 1.
+func TrailingDays(anchor d.Date, days int) (DateRange, error) {
+	var err = d.IsADate(anchor)
+	if err != nil {
+		return errorRange, err
+	}
+	if days < 1 {
+		return errorRange, errors.New("daterange.TrailingDays: number of days must be at least 1, not " +
+			strconv.Itoa(days))
+	}
+	var first d.Date
+	first, err = d.Add(anchor, 1-days)
+	if err != nil {
+		return errorRange, errors.New("daterange.TrailingDays: " + err.Error())
+	}
+	return New(first, anchor)
+}
+
+// TrailingMonths returns the date range of the number of months ending on
+// the anchor date.  The range begins the day after the same day the number
+// of months earlier, so 3 months ending 15-May-2024 is 16-Feb-2024 through
+// 15-May-2024.  If the anchor date is the last day of its month, the range
+// covers whole months, so 1 month ending 29-Feb-2024 is 1-Feb-2024 through
+// 29-Feb-2024.  The number of months must be at least 1.
+func TrailingMonths(anchor d.Date, months int) (DateRange, error) {
+	var err = d.IsADate(anchor)
+	if err != nil {
+		return errorRange, err
+	}
+	if months < 1 {
+		return errorRange, errors.New("daterange.TrailingMonths: number of months must be at least 1, not " +
+			strconv.Itoa(months))
+	}
+	var first d.Date
+	first, err = d.AddMonths(anchor, -months, lastDatePolicy)
+	if err == nil {
+		first, err = first.Increment()
+	}
+	if err != nil {
+		return errorRange, errors.New("daterange.TrailingMonths: " + err.Error())
+	}
+	return New(first, anchor)
+}
+
+// TrailingTwelveMonths returns the date range of the twelve months ending on
+// the anchor date, as in TrailingMonths.
+func TrailingTwelveMonths(anchor d.Date) (DateRange, error) {
+	return TrailingMonths(anchor, MonthsInTrailingYear)
+}
@@ -0,0 +1,226 @@
+// Package daycount implements the day-count conventions used to compute the
+// year fraction between two dates for interest accruals.
+package daycount
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	d "github.com/waysys/waydate/pkg/date"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Convention identifies a day-count convention.
+type Convention int
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// THIRTY360 is 30/360, also called the bond basis (ISDA 2006 4.16(f)).
+	// A first day of 31 becomes 30.  A last day of 31 becomes 30 if the first
+	// day is 30 or 31.
+	THIRTY360 Convention = 0
+	// THIRTYE360 is 30E/360, also called the Eurobond basis (ISDA 2006
+	// 4.16(g)).  A first or last day of 31 becomes 30.
+	THIRTYE360 Convention = 1
+	// ACT360 is Actual/360.  The actual number of days is divided by 360.
+	ACT360 Convention = 2
+	// ACT365F is Actual/365 Fixed.  The actual number of days is divided by
+	// 365, regardless of leap years.
+	ACT365F Convention = 3
+	// ACTACTISDA is Actual/Actual ISDA.  The days falling in a leap year are
+	// divided by 366 and the remaining days by 365.
+	ACTACTISDA Convention = 4
+	// ACTACTICMA is Actual/Actual ICMA.  The actual number of days is divided
+	// by the number of days in the coupon period times the coupon frequency.
+	// It requires a coupon period, so use YearFractionICMA.
+	ACTACTICMA Convention = 5
+)
+
+var namesConvention = []string{
+	"30/360",
+	"30E/360",
+	"ACT/360",
+	"ACT/365F",
+	"ACT/ACT ISDA",
+	"ACT/ACT ICMA",
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// isConvention returns an error if the convention is not valid.
+func isConvention(convention Convention) error {
+	var err error = nil
+	if convention < THIRTY360 || convention > ACTACTICMA {
+		err = errors.New("invalid day-count convention: " + strconv.Itoa(int(convention)))
+	}
+	return err
+}
+
+// DayCount returns the number of days from start to end under the
+// convention.  For the actual conventions, this is the actual number of days.
+// The result is negative if end is before start.
+func DayCount(convention Convention, start d.Date, end d.Date) (int, error) {
+	var err = validate(convention, start, end)
+	if err != nil {
+		return 0, err
+	}
+	if end.Before(start) {
+		var days, _ = DayCount(convention, end, start)
+		return -days, nil
+	}
+
+	var days int
+	switch convention {
+	case THIRTY360:
+		var day1 = start.Day()
+		var day2 = end.Day()
+		if day1 == 31 {
+			day1 = 30
+		}
+		if day2 == 31 && day1 == 30 {
+			day2 = 30
+		}
+		days = days360(start, end, day1, day2)
+	case THIRTYE360:
+		var day1 = start.Day()
+		var day2 = end.Day()
+		if day1 == 31 {
+			day1 = 30
+		}
+		if day2 == 31 {
+			day2 = 30
+		}
+		days = days360(start, end, day1, day2)
+	default:
+		days = d.Difference(end, start)
+	}
+	return days, nil
+}
+
+// YearFraction returns the fraction of a year from start to end under the
+// convention.  The result is negative if end is before start.  ACTACTICMA
+// is not supported because it requires a coupon period; use
+// YearFractionICMA instead.
+func YearFraction(convention Convention, start d.Date, end d.Date) (float64, error) {
+	var err = validate(convention, start, end)
+	if err != nil {
+		return 0, err
+	}
+	if end.Before(start) {
+		var fraction, err = YearFraction(convention, end, start)
+		return -fraction, err
+	}
+
+	var days, _ = DayCount(convention, start, end)
+	var fraction float64
+	switch convention {
+	case THIRTY360, THIRTYE360, ACT360:
+		fraction = float64(days) / 360
+	case ACT365F:
+		fraction = float64(days) / 365
+	case ACTACTISDA:
+		fraction = actActISDA(start, end)
+	case ACTACTICMA:
+		err = errors.New("daycount.YearFraction: ACT/ACT ICMA requires a coupon period; use YearFractionICMA")
+	}
+	return fraction, err
+}
+
+// YearFractionICMA returns the fraction of a year from start to end under
+// the Actual/Actual ICMA convention.  The coupon period runs from
+// periodStart up to periodEnd, the next coupon date, and frequency is the
+// number of coupon periods in a year.  The dates start and end must be in
+// the coupon period, with end possibly equal to periodEnd.
+func YearFractionICMA(start d.Date, end d.Date, periodStart d.Date, periodEnd d.Date, frequency int) (float64, error) {
+	var err = validate(ACTACTICMA, start, end)
+	if err != nil {
+		return 0, err
+	}
+	err = validate(ACTACTICMA, periodStart, periodEnd)
+	switch {
+	case err != nil:
+		return 0, err
+	case frequency < 1 || frequency > 12:
+		err = errors.New("coupon frequency must be from 1 to 12, not " + strconv.Itoa(frequency))
+	case !periodStart.Before(periodEnd):
+		err = errors.New("coupon period start " + periodStart.String() + " must be before end " +
+			periodEnd.String())
+	case end.Before(start):
+		err = errors.New("end date " + end.String() + " must not be before start date " + start.String())
+	case start.Before(periodStart) || end.After(periodEnd):
+		err = errors.New("dates " + start.String() + " and " + end.String() +
+			" must be within the coupon period " + periodStart.String() + " to " + periodEnd.String())
+	}
+	if err != nil {
+		return 0, errors.New("daycount.YearFractionICMA: " + err.Error())
+	}
+	var days = d.Difference(end, start)
+	var periodDays = d.Difference(periodEnd, periodStart)
+	var fraction = float64(days) / float64(frequency*periodDays)
+	return fraction, nil
+}
+
+// validate checks the convention and the dates.
+func validate(convention Convention, start d.Date, end d.Date) error {
+	var err = isConvention(convention)
+	if err == nil {
+		err = d.IsADate(start)
+	}
+	if err == nil {
+		err = d.IsADate(end)
+	}
+	return err
+}
+
+// days360 returns the number of days between two dates on a calendar of
+// twelve 30-day months, using the adjusted days of the month.
+func days360(start d.Date, end d.Date, day1 d.Day, day2 d.Day) int {
+	var days = 360*(int(end.Year())-int(start.Year())) +
+		30*(int(end.Month())-int(start.Month())) +
+		int(day2) - int(day1)
+	return days
+}
+
+// actActISDA returns the Actual/Actual ISDA year fraction.  Each calendar
+// year from start up to end contributes its days divided by the days in that
+// year.  The dates may be in either mode, so the years are computed in the
+// PROLEPTIC mode.
+//
+// Precondition: start is not after end
+func actActISDA(start d.Date, end d.Date) float64 {
+	var fraction = 0.0
+	var current = start
+	for current.Year() < end.Year() {
+		var nextYear, _ = d.PROLEPTIC.New(1, 1, current.Year()+1)
+		var daysInYear, _ = d.PROLEPTIC.DaysInYear(current.Year())
+		fraction += float64(d.Difference(nextYear, current)) / float64(daysInYear)
+		current = nextYear
+	}
+	var daysInYear, _ = d.PROLEPTIC.DaysInYear(end.Year())
+	fraction += float64(d.Difference(end, current)) / float64(daysInYear)
+	return fraction
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// String returns the market name of the convention.
+func (convention Convention) String() string {
+	if isConvention(convention) != nil {
+		return "Convention(" + strconv.Itoa(int(convention)) + ")"
+	}
+	return namesConvention[convention]
+}
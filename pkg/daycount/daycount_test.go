@@ -0,0 +1,136 @@
+// This file performs tests on the daycount package.
+package daycount
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	d "github.com/waysys/waydate/pkg/date"
+)
+
+// ----------------------------------------------------------------------------
+// Test Main
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+// ----------------------------------------------------------------------------
+// Support functions
+// ----------------------------------------------------------------------------
+
+// handle checks an error return.  If it is not nil, it calls t.Fatalf to
+// fail the test and print the error.
+func handle(err error, t *testing.T) {
+	if err != nil {
+		t.Fatalf("%s\n", err)
+	}
+}
+
+// newDate creates a date from an ISO 8601 string.  Dates before 1601 are
+// accepted.
+func newDate(value string, t *testing.T) d.Date {
+	var date, err = d.PROLEPTIC.Parse(d.ISO8601, value)
+	handle(err, t)
+	return date
+}
+
+// ----------------------------------------------------------------------------
+// Test year fractions
+// ----------------------------------------------------------------------------
+
+// Test_YearFraction checks each convention against known year fractions.
+func Test_YearFraction(t *testing.T) {
+	type aTest struct {
+		name       string
+		convention Convention
+		start      string
+		end        string
+		days       int
+		fraction   float64
+	}
+	var data = []aTest{
+		{"30/360 month ends", THIRTY360, "2024-01-31", "2024-03-31", 60, 60.0 / 360},
+		{"30/360 end 31 kept", THIRTY360, "2024-02-29", "2024-08-31", 182, 182.0 / 360},
+		{"30E/360 end 31", THIRTYE360, "2024-02-29", "2024-08-31", 181, 181.0 / 360},
+		{"30E/360 start 31", THIRTYE360, "2024-01-31", "2024-02-28", 28, 28.0 / 360},
+		{"ACT/360", ACT360, "2024-01-01", "2024-07-01", 182, 182.0 / 360},
+		{"ACT/365F leap year", ACT365F, "2024-01-01", "2025-01-01", 366, 366.0 / 365},
+		{"ACT/ACT ISDA across years", ACTACTISDA, "2023-12-15", "2024-01-15", 31, 17.0/365 + 14.0/366},
+		{"ACT/ACT ISDA leap year", ACTACTISDA, "2024-01-01", "2025-01-01", 366, 1.0},
+		{"ACT/ACT ISDA several years", ACTACTISDA, "2023-07-01", "2025-07-01", 731,
+			184.0/365 + 1.0 + 181.0/365},
+		{"reversed", ACT360, "2024-07-01", "2024-01-01", -182, -182.0 / 360},
+		{"ACT/ACT ISDA before 1601", ACTACTISDA, "1599-12-15", "1600-01-15", 31, 17.0/365 + 14.0/366},
+		{"ACT/ACT ISDA across 1601", ACTACTISDA, "1600-07-01", "1601-07-01", 365, 184.0/366 + 181.0/365},
+		{"ACT/ACT ISDA proleptic", ACTACTISDA, "-0045-01-01", "-0043-01-01", 731, 2.0},
+		{"30/360 before 1601", THIRTY360, "1500-01-31", "1500-03-31", 60, 60.0 / 360},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var start = newDate(tt.start, t)
+		var end = newDate(tt.end, t)
+		var days, err = DayCount(tt.convention, start, end)
+		handle(err, t)
+		if days != tt.days {
+			t.Errorf("%s day count is %d, not %d", tt.convention, days, tt.days)
+		}
+		var fraction float64
+		fraction, err = YearFraction(tt.convention, start, end)
+		handle(err, t)
+		if math.Abs(fraction-tt.fraction) > 1e-12 {
+			t.Errorf("%s year fraction is %f, not %f", tt.convention, fraction, tt.fraction)
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+
+	var _, err = YearFraction(ACTACTICMA, newDate("2024-01-01", t), newDate("2024-07-01", t))
+	if err == nil {
+		t.Error("YearFraction did not reject ACT/ACT ICMA")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = YearFraction(Convention(9), newDate("2024-01-01", t), newDate("2024-07-01", t))
+	if err == nil {
+		t.Error("YearFraction did not reject an invalid convention")
+	}
+}
+
+// Test_YearFractionICMA checks the Actual/Actual ICMA convention.
+func Test_YearFractionICMA(t *testing.T) {
+	var periodStart = newDate("2024-01-15", t)
+	var periodEnd = newDate("2024-07-15", t)
+
+	var fraction, err = YearFractionICMA(periodStart, newDate("2024-04-15", t), periodStart, periodEnd, 2)
+	handle(err, t)
+	if math.Abs(fraction-91.0/364) > 1e-12 {
+		t.Errorf("Wrong ICMA year fraction: %f", fraction)
+	}
+	fraction, err = YearFractionICMA(periodStart, periodEnd, periodStart, periodEnd, 2)
+	handle(err, t)
+	if math.Abs(fraction-0.5) > 1e-12 {
+		t.Errorf("A full semiannual period should be 0.5, not %f", fraction)
+	}
+	_, err = YearFractionICMA(newDate("2024-01-01", t), periodEnd, periodStart, periodEnd, 2)
+	if err == nil {
+		t.Error("YearFractionICMA did not reject a start date outside the coupon period")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = YearFractionICMA(periodStart, periodEnd, periodStart, periodEnd, 0)
+	if err == nil {
+		t.Error("YearFractionICMA did not reject a zero frequency")
+	}
+}
@@ -0,0 +1,260 @@
+// Package fiscal implements fiscal calendars, which divide a fiscal year that
+// need not start in January into periods and quarters.
+// Structures in this package are intended to be invariant.
+package fiscal
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	d "github.com/waysys/waydate/pkg/date"
+	dr "github.com/waysys/waydate/pkg/daterange"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// FiscalCalendar divides fiscal years starting on the first day of a month
+// into twelve periods of one calendar month each, and four quarters of three
+// periods each.  A fiscal year is named for the calendar year in which it
+// ends, so with a fiscal year starting 1-July, fiscal year 2025 runs from
+// 1-Jul-2024 through 30-Jun-2025.  The zero value of FiscalCalendar has
+// fiscal years starting in January.
+type FiscalCalendar struct {
+	startMonth d.Month
+}
+
+// FiscalPeriod identifies a period of a fiscal year.
+//
+//	1 <= Period <= 12
+type FiscalPeriod struct {
+	Year   d.Year
+	Period int
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	PeriodsInYear    = 12
+	QuartersInYear   = 4
+	PeriodsInQuarter = 3
+)
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewFiscalCalendar returns a fiscal calendar with fiscal years starting on
+// the first day of the specified month.
+func NewFiscalCalendar(startMonth d.Month) (FiscalCalendar, error) {
+	if startMonth < 1 || startMonth > 12 {
+		var err = errors.New("fiscal.NewFiscalCalendar: start month must be from 1 to 12, not " +
+			strconv.Itoa(int(startMonth)))
+		return FiscalCalendar{}, err
+	}
+	var calendar = FiscalCalendar{
+		startMonth: startMonth,
+	}
+	return calendar, nil
+}
+
+// NewFiscalPeriod returns the fiscal period for the fiscal year and period.
+func NewFiscalPeriod(year d.Year, period int) (FiscalPeriod, error) {
+	if period < 1 || period > PeriodsInYear {
+		var err = errors.New("fiscal.NewFiscalPeriod: period must be from 1 to 12, not " + strconv.Itoa(period))
+		return FiscalPeriod{}, err
+	}
+	var fiscalPeriod = FiscalPeriod{
+		Year:   year,
+		Period: period,
+	}
+	return fiscalPeriod, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - FiscalCalendar
+// ----------------------------------------------------------------------------
+
+// StartMonth returns the month in which fiscal years start.  The zero value
+// of FiscalCalendar starts in January.
+func (calendar FiscalCalendar) StartMonth() d.Month {
+	if calendar.startMonth == 0 {
+		return 1
+	}
+	return calendar.startMonth
+}
+
+// FiscalYear returns the fiscal year containing the date.
+func (calendar FiscalCalendar) FiscalYear(date d.Date) d.Year {
+	var year = date.Year()
+	var startMonth = calendar.StartMonth()
+	if startMonth > 1 && date.Month() >= startMonth {
+		year++
+	}
+	return year
+}
+
+// Period returns the fiscal period containing the date.
+func (calendar FiscalCalendar) Period(date d.Date) FiscalPeriod {
+	var period = (int(date.Month())-int(calendar.StartMonth())+12)%12 + 1
+	var fiscalPeriod = FiscalPeriod{
+		Year:   calendar.FiscalYear(date),
+		Period: period,
+	}
+	return fiscalPeriod
+}
+
+// Quarter returns the fiscal quarter, 1 through 4, containing the date.
+func (calendar FiscalCalendar) Quarter(date d.Date) int {
+	return calendar.Period(date).Quarter()
+}
+
+// YearMonth returns the calendar month of the fiscal period.
+func (calendar FiscalCalendar) YearMonth(fiscalPeriod FiscalPeriod) (d.YearMonth, error) {
+	var err = isFiscalPeriod(fiscalPeriod)
+	if err != nil {
+		return d.YearMonth{}, err
+	}
+	var year = int(fiscalPeriod.Year)
+	var startMonth = calendar.StartMonth()
+	if startMonth > 1 {
+		year--
+	}
+	var index = int(startMonth) - 1 + fiscalPeriod.Period - 1
+	return d.NewYearMonth(year+index/12, index%12+1)
+}
+
+// PeriodRange returns the date range of the fiscal period.
+func (calendar FiscalCalendar) PeriodRange(fiscalPeriod FiscalPeriod) (dr.DateRange, error) {
+	var yearMonth, err = calendar.YearMonth(fiscalPeriod)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	return monthRange(yearMonth, yearMonth)
+}
+
+// QuarterRange returns the date range of the quarter of the fiscal year.
+func (calendar FiscalCalendar) QuarterRange(year d.Year, quarter int) (dr.DateRange, error) {
+	if quarter < 1 || quarter > QuartersInYear {
+		var err = errors.New("fiscal.QuarterRange: quarter must be from 1 to 4, not " + strconv.Itoa(quarter))
+		return dr.DateRange{}, err
+	}
+	var first = FiscalPeriod{year, (quarter-1)*PeriodsInQuarter + 1}
+	var last = FiscalPeriod{year, quarter * PeriodsInQuarter}
+	return calendar.spanRange(first, last)
+}
+
+// YearRange returns the date range of the fiscal year.
+func (calendar FiscalCalendar) YearRange(year d.Year) (dr.DateRange, error) {
+	return calendar.spanRange(FiscalPeriod{year, 1}, FiscalPeriod{year, PeriodsInYear})
+}
+
+// Periods returns a slice with the fiscal periods from one fiscal period
+// through another, in the manner of date.Keys.
+func (calendar FiscalCalendar) Periods(from FiscalPeriod, thru FiscalPeriod) ([]FiscalPeriod, error) {
+	var periods []FiscalPeriod
+	var err = isFiscalPeriod(from)
+	if err == nil {
+		err = isFiscalPeriod(thru)
+	}
+	if err != nil {
+		return periods, err
+	}
+
+	for fiscalPeriod := from; !thru.Before(fiscalPeriod); fiscalPeriod = fiscalPeriod.Next() {
+		periods = append(periods, fiscalPeriod)
+	}
+	return periods, nil
+}
+
+// spanRange returns the date range from the start of the first fiscal period
+// through the end of the last fiscal period.
+func (calendar FiscalCalendar) spanRange(first FiscalPeriod, last FiscalPeriod) (dr.DateRange, error) {
+	var firstMonth, err = calendar.YearMonth(first)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	var lastMonth d.YearMonth
+	lastMonth, err = calendar.YearMonth(last)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	return monthRange(firstMonth, lastMonth)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - FiscalPeriod
+// ----------------------------------------------------------------------------
+
+// Quarter returns the fiscal quarter, 1 through 4, of the fiscal period.
+func (fiscalPeriod FiscalPeriod) Quarter() int {
+	return (fiscalPeriod.Period-1)/PeriodsInQuarter + 1
+}
+
+// Next returns the following fiscal period.
+func (fiscalPeriod FiscalPeriod) Next() FiscalPeriod {
+	if fiscalPeriod.Period == PeriodsInYear {
+		return FiscalPeriod{fiscalPeriod.Year + 1, 1}
+	}
+	return FiscalPeriod{fiscalPeriod.Year, fiscalPeriod.Period + 1}
+}
+
+// Prev returns the preceding fiscal period.
+func (fiscalPeriod FiscalPeriod) Prev() FiscalPeriod {
+	if fiscalPeriod.Period == 1 {
+		return FiscalPeriod{fiscalPeriod.Year - 1, PeriodsInYear}
+	}
+	return FiscalPeriod{fiscalPeriod.Year, fiscalPeriod.Period - 1}
+}
+
+// Before returns true if the fiscal period is before the argument.
+func (fiscalPeriod FiscalPeriod) Before(another FiscalPeriod) bool {
+	return fiscalPeriod.Year < another.Year ||
+		(fiscalPeriod.Year == another.Year && fiscalPeriod.Period < another.Period)
+}
+
+// String returns the fiscal period in the form FY2025-P01.
+func (fiscalPeriod FiscalPeriod) String() string {
+	var period = strconv.Itoa(fiscalPeriod.Period)
+	if fiscalPeriod.Period < 10 {
+		period = "0" + period
+	}
+	return "FY" + strconv.Itoa(int(fiscalPeriod.Year)) + "-P" + period
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// isFiscalPeriod returns an error if the period is not from 1 to 12.
+func isFiscalPeriod(fiscalPeriod FiscalPeriod) error {
+	var _, err = NewFiscalPeriod(fiscalPeriod.Year, fiscalPeriod.Period)
+	return err
+}
+
+// monthRange returns the date range from the first day of the first month
+// through the last day of the last month.
+func monthRange(firstMonth d.YearMonth, lastMonth d.YearMonth) (dr.DateRange, error) {
+	var first, err = d.New(d.Month(firstMonth.Month), 1, d.Year(firstMonth.Year))
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	var lastDay int
+	lastDay, err = d.DaysInMonth(d.Month(lastMonth.Month), d.Year(lastMonth.Year))
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	var last d.Date
+	last, err = d.New(d.Month(lastMonth.Month), d.Day(lastDay), d.Year(lastMonth.Year))
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	return dr.New(first, last)
+}
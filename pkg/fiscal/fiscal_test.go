@@ -0,0 +1,307 @@
+// This file performs tests on the fiscal package.
+package fiscal
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	d "github.com/waysys/waydate/pkg/date"
+)
+
+// ----------------------------------------------------------------------------
+// Test Main
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+// ----------------------------------------------------------------------------
+// Support functions
+// ----------------------------------------------------------------------------
+
+// handle checks an error return.  If it is not nil, it calls t.Fatalf to
+// fail the test and print the error.
+func handle(err error, t *testing.T) {
+	if err != nil {
+		t.Fatalf("%s\n", err)
+	}
+}
+
+// newDate creates a date from an ISO 8601 string.
+func newDate(value string, t *testing.T) d.Date {
+	var date, err = d.Parse(d.ISO8601, value)
+	handle(err, t)
+	return date
+}
+
+// ----------------------------------------------------------------------------
+// Test fiscal calendar
+// ----------------------------------------------------------------------------
+
+// Test_FiscalCalendar checks the mapping of dates to fiscal years, quarters,
+// and periods.
+func Test_FiscalCalendar(t *testing.T) {
+	type aTest struct {
+		name       string
+		startMonth d.Month
+		date       string
+		year       d.Year
+		quarter    int
+		period     int
+	}
+	var data = []aTest{
+		{"July start, first day", 7, "2024-07-01", 2025, 1, 1},
+		{"July start, last day", 7, "2025-06-30", 2025, 4, 12},
+		{"July start, December", 7, "2024-12-31", 2025, 2, 6},
+		{"July start, January", 7, "2025-01-01", 2025, 3, 7},
+		{"October start", 10, "2024-10-16", 2025, 1, 1},
+		{"October start, September", 10, "2024-09-30", 2024, 4, 12},
+		{"January start", 1, "2024-10-16", 2024, 4, 10},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var calendar, err = NewFiscalCalendar(tt.startMonth)
+		handle(err, t)
+		var date = newDate(tt.date, t)
+		var fiscalPeriod = calendar.Period(date)
+		if fiscalPeriod.Year != tt.year || fiscalPeriod.Period != tt.period {
+			t.Fatalf("Date %s is in %s, not FY%d period %d", tt.date, fiscalPeriod, tt.year, tt.period)
+		}
+		if calendar.Quarter(date) != tt.quarter {
+			t.Fatalf("Date %s is in quarter %d, not %d", tt.date, calendar.Quarter(date), tt.quarter)
+		}
+		var dateRange, err2 = calendar.PeriodRange(fiscalPeriod)
+		handle(err2, t)
+		if !dateRange.InRange(date) {
+			t.Fatalf("Date %s is not in the range %s of %s", tt.date, dateRange, fiscalPeriod)
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+
+	var _, err = NewFiscalCalendar(13)
+	if err == nil {
+		t.Error("NewFiscalCalendar did not detect an invalid month")
+	} else {
+		fmt.Println(err)
+	}
+
+	var january, _ = NewFiscalCalendar(1)
+	var zero = FiscalCalendar{}
+	for _, value := range []string{"2024-01-01", "2024-10-16", "2024-12-31"} {
+		var date = newDate(value, t)
+		if zero.Period(date) != january.Period(date) || zero.Quarter(date) != january.Quarter(date) {
+			t.Errorf("Zero calendar puts %s in %s, not %s", value, zero.Period(date), january.Period(date))
+		}
+	}
+	var yearRange, err2 = zero.YearRange(2024)
+	handle(err2, t)
+	if zero.StartMonth() != 1 || yearRange.First() != newDate("2024-01-01", t) {
+		t.Errorf("Zero calendar starts in month %d, year range %s", zero.StartMonth(), yearRange)
+	}
+}
+
+// Test_FiscalRanges checks the date ranges of fiscal periods, quarters, and
+// years.
+func Test_FiscalRanges(t *testing.T) {
+	var calendar, err = NewFiscalCalendar(7)
+	handle(err, t)
+
+	var fiscalPeriod, err2 = NewFiscalPeriod(2024, 8)
+	handle(err2, t)
+	var dateRange, err3 = calendar.PeriodRange(fiscalPeriod)
+	handle(err3, t)
+	if dateRange.First() != newDate("2024-02-01", t) || dateRange.Last() != newDate("2024-02-29", t) {
+		t.Errorf("Wrong range for %s: %s", fiscalPeriod, dateRange)
+	}
+
+	dateRange, err = calendar.QuarterRange(2025, 2)
+	handle(err, t)
+	if dateRange.First() != newDate("2024-10-01", t) || dateRange.Last() != newDate("2024-12-31", t) {
+		t.Errorf("Wrong range for FY2025 Q2: %s", dateRange)
+	}
+
+	dateRange, err = calendar.YearRange(2025)
+	handle(err, t)
+	if dateRange.First() != newDate("2024-07-01", t) || dateRange.Last() != newDate("2025-06-30", t) {
+		t.Errorf("Wrong range for FY2025: %s", dateRange)
+	}
+
+	_, err = calendar.QuarterRange(2025, 5)
+	if err == nil {
+		t.Error("QuarterRange did not detect an invalid quarter")
+	}
+	_, err = NewFiscalPeriod(2025, 13)
+	if err == nil {
+		t.Error("NewFiscalPeriod did not detect an invalid period")
+	}
+}
+
+// Test_Periods checks the enumeration of fiscal periods.
+func Test_Periods(t *testing.T) {
+	var calendar, err = NewFiscalCalendar(10)
+	handle(err, t)
+	var from, _ = NewFiscalPeriod(2024, 11)
+	var thru, _ = NewFiscalPeriod(2026, 2)
+	var periods, err2 = calendar.Periods(from, thru)
+	handle(err2, t)
+
+	if len(periods) != 16 {
+		t.Fatalf("Wrong number of periods: %d", len(periods))
+	}
+	if periods[0] != from || periods[len(periods)-1] != thru {
+		t.Fatalf("Wrong first or last period: %s %s", periods[0], periods[len(periods)-1])
+	}
+	if periods[2].String() != "FY2025-P01" {
+		t.Fatalf("Wrong period after year end: %s", periods[2])
+	}
+	for index := 1; index < len(periods); index++ {
+		var previous, _ = calendar.PeriodRange(periods[index-1])
+		var current, _ = calendar.PeriodRange(periods[index])
+		var next, _ = previous.Last().Increment()
+		if next != current.First() {
+			t.Fatalf("Periods %s and %s are not consecutive", periods[index-1], periods[index])
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test retail calendar
+// ----------------------------------------------------------------------------
+
+// Test_RetailYear checks the fiscal year boundaries of the NRF calendar and
+// of a calendar ending on the last Saturday of January, and the
+// identification of 53-week years.
+func Test_RetailYear(t *testing.T) {
+	type aTest struct {
+		name     string
+		calendar RetailCalendar
+		year     d.Year
+		first    string
+		last     string
+		weeks    int
+	}
+	var lastSaturday, err = NewRetailCalendar(PATTERN454, 1, d.SATURDAY)
+	handle(err, t)
+	var data = []aTest{
+		{"NRF 2022", NRF, 2022, "2022-01-30", "2023-01-28", 52},
+		{"NRF 2023", NRF, 2023, "2023-01-29", "2024-02-03", 53},
+		{"NRF 2024", NRF, 2024, "2024-02-04", "2025-02-01", 52},
+		{"NRF 2025", NRF, 2025, "2025-02-02", "2026-01-31", 52},
+		{"NRF 2026", NRF, 2026, "2026-02-01", "2027-01-30", 52},
+		{"last Saturday 2024", lastSaturday, 2024, "2023-01-29", "2024-01-27", 52},
+		{"last Saturday 2025", lastSaturday, 2025, "2024-01-28", "2025-01-25", 52},
+		{"last Saturday 2026", lastSaturday, 2026, "2025-01-26", "2026-01-31", 53},
+		{"last Saturday 2027", lastSaturday, 2027, "2026-02-01", "2027-01-30", 52},
+	}
+
+	var tt aTest
+	var testFunction = func(t *testing.T) {
+		var dateRange, err = tt.calendar.YearRange(tt.year)
+		handle(err, t)
+		if dateRange.First() != newDate(tt.first, t) || dateRange.Last() != newDate(tt.last, t) {
+			t.Fatalf("Wrong range for FY%d: %s", tt.year, dateRange)
+		}
+		var weeks, err2 = tt.calendar.WeeksInYear(tt.year)
+		handle(err2, t)
+		if weeks != tt.weeks {
+			t.Fatalf("FY%d has %d weeks, not %d", tt.year, weeks, tt.weeks)
+		}
+		var is53, _ = tt.calendar.Is53WeekYear(tt.year)
+		if is53 != (tt.weeks == 53) {
+			t.Fatalf("Is53WeekYear is wrong for FY%d", tt.year)
+		}
+		var year, _ = tt.calendar.FiscalYear(dateRange.First())
+		var year2, _ = tt.calendar.FiscalYear(dateRange.Last())
+		if year != tt.year || year2 != tt.year {
+			t.Fatalf("Boundary dates of FY%d are in FY%d and FY%d", tt.year, year, year2)
+		}
+	}
+	for _, item := range data {
+		tt = item
+		t.Run(item.name, testFunction)
+	}
+}
+
+// Test_RetailPeriods checks the periods and weeks of retail calendars.
+func Test_RetailPeriods(t *testing.T) {
+	var date = newDate("2024-03-05", t)
+	var year, week, err = NRF.Week(date)
+	handle(err, t)
+	if year != 2024 || week != 5 {
+		t.Errorf("Date %s is in FY%d week %d", date, year, week)
+	}
+	var fiscalPeriod, err2 = NRF.Period(date)
+	handle(err2, t)
+	if fiscalPeriod.String() != "FY2024-P02" || fiscalPeriod.Quarter() != 1 {
+		t.Errorf("Date %s is in %s", date, fiscalPeriod)
+	}
+
+	var patterns = []Pattern{PATTERN445, PATTERN454, PATTERN544}
+	for _, pattern := range patterns {
+		var calendar, err = NewRetailCalendar(pattern, 12, d.SATURDAY)
+		handle(err, t)
+		for _, year := range []d.Year{2024, 2025, 2026, 2027, 2028} {
+			var yearRange, _ = calendar.YearRange(year)
+			var weeks, _ = calendar.WeeksInYear(year)
+			var expected = yearRange.First()
+			var totalWeeks = 0
+			for period := 1; period <= PeriodsInYear; period++ {
+				var periodRange, err = calendar.PeriodRange(FiscalPeriod{year, period})
+				handle(err, t)
+				if periodRange.First() != expected {
+					t.Fatalf("Pattern %d FY%d period %d starts on %s", pattern, year, period, periodRange.First())
+				}
+				var found, _ = calendar.Period(periodRange.Last())
+				if found.Period != period {
+					t.Fatalf("Pattern %d FY%d period %d ends in period %d", pattern, year, period, found.Period)
+				}
+				totalWeeks += (periodRange.Size() + 1) / 7
+				expected, _ = periodRange.Last().Increment()
+			}
+			if totalWeeks != weeks {
+				t.Fatalf("Pattern %d FY%d periods have %d weeks, not %d", pattern, year, totalWeeks, weeks)
+			}
+			var quarterRange, _ = calendar.QuarterRange(year, 1)
+			if (quarterRange.Size()+1)/7 != WeeksInQuarter {
+				t.Fatalf("Pattern %d FY%d quarter 1 is not 13 weeks", pattern, year)
+			}
+		}
+	}
+
+	var weekRange, err3 = NRF.WeekRange(2023, 53)
+	handle(err3, t)
+	if weekRange.First() != newDate("2024-01-28", t) || weekRange.Last() != newDate("2024-02-03", t) {
+		t.Errorf("Wrong range for week 53 of FY2023: %s", weekRange)
+	}
+	year, _ = NRF.FiscalYear(newDate("2024-02-01", t))
+	if year != 2023 {
+		t.Errorf("Date 1-Feb-2024 is in FY%d, not FY2023", year)
+	}
+	_, err = NRF.WeekRange(2025, 53)
+	if err == nil {
+		t.Error("WeekRange did not detect week 53 of a 52-week year")
+	} else {
+		fmt.Println(err)
+	}
+	_, err = NewRetailCalendar(Pattern(3), 1, d.SATURDAY)
+	if err == nil {
+		t.Error("NewRetailCalendar did not detect an invalid pattern")
+	}
+	_, err = NewRetailCalendarWithRules(PATTERN454, 1, d.SATURDAY, YearEndRule(2), ENDYEAR)
+	if err == nil {
+		t.Error("NewRetailCalendarWithRules did not detect an invalid year end rule")
+	} else {
+		fmt.Println(err)
+	}
+}
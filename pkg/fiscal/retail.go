@@ -0,0 +1,333 @@
+package fiscal
+
+// This file implements retail calendars, such as the NRF 4-5-4 calendar.  A
+// retail fiscal year ends on a day of the week chosen by a rule, either the
+// last such day in a month or the such day nearest the end of a month, for
+// example the Saturday nearest 31 January.  Every fiscal year therefore has
+// a whole number of weeks: 52, or 53 when the extra days accumulate to a
+// full week.  The year is divided into four quarters of 13 weeks, and each
+// quarter into three periods of 4 or 5 weeks according to the pattern.  In a
+// 53-week year, the extra week is added to the last period.
+//
+// By default, as with FiscalCalendar, a fiscal year is named for the
+// calendar year in which it ends.  The NRF calendar instead names a fiscal
+// year for the calendar year in which it starts, so NRF fiscal year 2025
+// runs from 2-Feb-2025 through 31-Jan-2026.
+
+// ----------------------------------------------------------------------------
+// Imports
+// ----------------------------------------------------------------------------
+
+import (
+	"errors"
+	"strconv"
+
+	d "github.com/waysys/waydate/pkg/date"
+	dr "github.com/waysys/waydate/pkg/daterange"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Pattern is the number of weeks in each period of a quarter.
+type Pattern int
+
+// YearEndRule selects the day on which a retail fiscal year ends.
+type YearEndRule int
+
+// YearNaming selects the calendar year for which a retail fiscal year is
+// named.
+type YearNaming int
+
+// RetailCalendar is a week-based fiscal calendar.
+type RetailCalendar struct {
+	pattern    Pattern
+	endMonth   d.Month
+	endWeekDay d.DayOfWeek
+	yearEnd    YearEndRule
+	naming     YearNaming
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	PATTERN445 Pattern = 0
+	PATTERN454 Pattern = 1
+	PATTERN544 Pattern = 2
+)
+
+var weeksInPeriods = [][]int{
+	{4, 4, 5},
+	{4, 5, 4},
+	{5, 4, 4},
+}
+
+const (
+	// LAST ends a fiscal year on the last day of the week in the end month.
+	LAST YearEndRule = 0
+	// NEAREST ends a fiscal year on the day of the week nearest the last day
+	// of the end month, which may be in the following month.
+	NEAREST YearEndRule = 1
+)
+
+const (
+	// ENDYEAR names a fiscal year for the calendar year of its end month.
+	ENDYEAR YearNaming = 0
+	// STARTYEAR names a fiscal year for the calendar year before that of its
+	// end month, which is the year in which it starts when the end month is
+	// early in the year.
+	STARTYEAR YearNaming = 1
+)
+
+const WeeksInQuarter = 13
+
+// NRF is the National Retail Federation 4-5-4 calendar with fiscal years
+// ending on the Saturday nearest 31 January and named for the year in which
+// they start.
+var NRF, _ = NewRetailCalendarWithRules(PATTERN454, 1, d.SATURDAY, NEAREST, STARTYEAR)
+
+// ----------------------------------------------------------------------------
+// Factory Functions
+// ----------------------------------------------------------------------------
+
+// NewRetailCalendar returns a retail calendar with the pattern and with
+// fiscal years ending on the last endWeekDay of endMonth.  A fiscal year is
+// named for the calendar year in which it ends.
+func NewRetailCalendar(pattern Pattern, endMonth d.Month, endWeekDay d.DayOfWeek) (RetailCalendar, error) {
+	return NewRetailCalendarWithRules(pattern, endMonth, endWeekDay, LAST, ENDYEAR)
+}
+
+// NewRetailCalendarWithRules returns a retail calendar with the pattern and
+// with fiscal years ending on the endWeekDay of endMonth selected by the
+// year end rule and named according to the naming.
+func NewRetailCalendarWithRules(pattern Pattern, endMonth d.Month, endWeekDay d.DayOfWeek, yearEnd YearEndRule,
+	naming YearNaming) (RetailCalendar, error) {
+	var err error
+	switch {
+	case pattern < PATTERN445 || pattern > PATTERN544:
+		err = errors.New("invalid pattern: " + strconv.Itoa(int(pattern)))
+	case endMonth < 1 || endMonth > 12:
+		err = errors.New("end month must be from 1 to 12, not " + strconv.Itoa(int(endMonth)))
+	case endWeekDay < d.SUNDAY || endWeekDay > d.SATURDAY:
+		err = errors.New("end day of week must be from 0 to 6, not " + strconv.Itoa(int(endWeekDay)))
+	case yearEnd < LAST || yearEnd > NEAREST:
+		err = errors.New("invalid year end rule: " + strconv.Itoa(int(yearEnd)))
+	case naming < ENDYEAR || naming > STARTYEAR:
+		err = errors.New("invalid year naming: " + strconv.Itoa(int(naming)))
+	}
+	if err != nil {
+		return RetailCalendar{}, errors.New("fiscal.NewRetailCalendar: " + err.Error())
+	}
+	var calendar = RetailCalendar{
+		pattern:    pattern,
+		endMonth:   endMonth,
+		endWeekDay: endWeekDay,
+		yearEnd:    yearEnd,
+		naming:     naming,
+	}
+	return calendar, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - year
+// ----------------------------------------------------------------------------
+
+// YearEnd returns the last day of the fiscal year.
+func (calendar RetailCalendar) YearEnd(year d.Year) (d.Date, error) {
+	if calendar.naming == STARTYEAR {
+		year++
+	}
+	var _, err = d.New(calendar.endMonth, 1, year)
+	if err != nil {
+		return d.Date{}, err
+	}
+	if calendar.yearEnd == NEAREST {
+		var lastDay, _ = d.DaysInMonth(calendar.endMonth, year)
+		var monthEnd, _ = d.New(calendar.endMonth, d.Day(lastDay), year)
+		return monthEnd.NearestDayOfWeek(calendar.endWeekDay)
+	}
+	return d.LastWeekDayOfMonth(calendar.endMonth, year, calendar.endWeekDay)
+}
+
+// YearStart returns the first day of the fiscal year, which is the day after
+// the end of the previous fiscal year.
+func (calendar RetailCalendar) YearStart(year d.Year) (d.Date, error) {
+	var previousEnd, err = calendar.YearEnd(year - 1)
+	if err != nil {
+		return d.Date{}, err
+	}
+	return previousEnd.Increment()
+}
+
+// YearRange returns the date range of the fiscal year.
+func (calendar RetailCalendar) YearRange(year d.Year) (dr.DateRange, error) {
+	var first, err = calendar.YearStart(year)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	var last d.Date
+	last, err = calendar.YearEnd(year)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	return dr.New(first, last)
+}
+
+// WeeksInYear returns the number of weeks in the fiscal year, 52 or 53.
+func (calendar RetailCalendar) WeeksInYear(year d.Year) (int, error) {
+	var dateRange, err = calendar.YearRange(year)
+	if err != nil {
+		return 0, err
+	}
+	return (dateRange.Size() + 1) / 7, nil
+}
+
+// Is53WeekYear returns true if the fiscal year has 53 weeks.
+func (calendar RetailCalendar) Is53WeekYear(year d.Year) (bool, error) {
+	var weeks, err = calendar.WeeksInYear(year)
+	return weeks == 53, err
+}
+
+// FiscalYear returns the fiscal year containing the date.
+func (calendar RetailCalendar) FiscalYear(date d.Date) (d.Year, error) {
+	var year = date.Year()
+	if calendar.naming == STARTYEAR {
+		year--
+	}
+	var yearEnd, err = calendar.YearEnd(year)
+	if err != nil {
+		return 0, err
+	}
+	if date.After(yearEnd) {
+		return year + 1, nil
+	}
+	var yearStart d.Date
+	yearStart, err = calendar.YearStart(year)
+	if err == nil && date.Before(yearStart) {
+		year--
+	}
+	return year, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - weeks and periods
+// ----------------------------------------------------------------------------
+
+// Week returns the fiscal year and the week of the fiscal year, 1 through 53,
+// containing the date.
+func (calendar RetailCalendar) Week(date d.Date) (d.Year, int, error) {
+	var year, err = calendar.FiscalYear(date)
+	if err != nil {
+		return 0, 0, err
+	}
+	var start d.Date
+	start, err = calendar.YearStart(year)
+	if err != nil {
+		return 0, 0, err
+	}
+	var week = d.Difference(date, start)/7 + 1
+	return year, week, nil
+}
+
+// Period returns the fiscal period containing the date.
+func (calendar RetailCalendar) Period(date d.Date) (FiscalPeriod, error) {
+	var year, week, err = calendar.Week(date)
+	if err != nil {
+		return FiscalPeriod{}, err
+	}
+	var period = 1
+	var lastWeek = calendar.weeksInPeriod(period)
+	for week > lastWeek && period < PeriodsInYear {
+		period++
+		lastWeek += calendar.weeksInPeriod(period)
+	}
+	return FiscalPeriod{year, period}, nil
+}
+
+// Quarter returns the fiscal quarter, 1 through 4, containing the date.
+func (calendar RetailCalendar) Quarter(date d.Date) (int, error) {
+	var fiscalPeriod, err = calendar.Period(date)
+	return fiscalPeriod.Quarter(), err
+}
+
+// WeekRange returns the date range of the week of the fiscal year.
+func (calendar RetailCalendar) WeekRange(year d.Year, week int) (dr.DateRange, error) {
+	var weeks, err = calendar.WeeksInYear(year)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	if week < 1 || week > weeks {
+		err = errors.New("fiscal.WeekRange: week must be from 1 to " + strconv.Itoa(weeks) + ", not " +
+			strconv.Itoa(week))
+		return dr.DateRange{}, err
+	}
+	return calendar.weeksRange(year, week, week)
+}
+
+// PeriodRange returns the date range of the fiscal period.
+func (calendar RetailCalendar) PeriodRange(fiscalPeriod FiscalPeriod) (dr.DateRange, error) {
+	var err = isFiscalPeriod(fiscalPeriod)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	return calendar.periodsRange(fiscalPeriod.Year, fiscalPeriod.Period, fiscalPeriod.Period)
+}
+
+// QuarterRange returns the date range of the quarter of the fiscal year.
+func (calendar RetailCalendar) QuarterRange(year d.Year, quarter int) (dr.DateRange, error) {
+	if quarter < 1 || quarter > QuartersInYear {
+		var err = errors.New("fiscal.QuarterRange: quarter must be from 1 to 4, not " + strconv.Itoa(quarter))
+		return dr.DateRange{}, err
+	}
+	return calendar.periodsRange(year, (quarter-1)*PeriodsInQuarter+1, quarter*PeriodsInQuarter)
+}
+
+// weeksInPeriod returns the number of weeks in the period, excluding the
+// extra week of a 53-week year.
+func (calendar RetailCalendar) weeksInPeriod(period int) int {
+	return weeksInPeriods[calendar.pattern][(period-1)%PeriodsInQuarter]
+}
+
+// periodsRange returns the date range from the start of the first period
+// through the end of the last period of the fiscal year.
+func (calendar RetailCalendar) periodsRange(year d.Year, firstPeriod int, lastPeriod int) (dr.DateRange, error) {
+	var firstWeek = 1
+	for period := 1; period < firstPeriod; period++ {
+		firstWeek += calendar.weeksInPeriod(period)
+	}
+	var lastWeek = firstWeek - 1
+	for period := firstPeriod; period <= lastPeriod; period++ {
+		lastWeek += calendar.weeksInPeriod(period)
+	}
+	if lastPeriod == PeriodsInYear {
+		var weeks, err = calendar.WeeksInYear(year)
+		if err != nil {
+			return dr.DateRange{}, err
+		}
+		lastWeek = weeks
+	}
+	return calendar.weeksRange(year, firstWeek, lastWeek)
+}
+
+// weeksRange returns the date range from the start of the first week through
+// the end of the last week of the fiscal year.
+func (calendar RetailCalendar) weeksRange(year d.Year, firstWeek int, lastWeek int) (dr.DateRange, error) {
+	var start, err = calendar.YearStart(year)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	var first d.Date
+	first, err = d.Add(start, 7*(firstWeek-1))
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	var last d.Date
+	last, err = d.Add(start, 7*lastWeek-1)
+	if err != nil {
+		return dr.DateRange{}, err
+	}
+	return dr.New(first, last)
+}